@@ -0,0 +1,129 @@
+package gostradamus
+
+import "fmt"
+
+// AllenRelation is one of the thirteen relations of Allen's interval algebra,
+// which classify how two Intervals are positioned to each other.
+// Exactly one AllenRelation holds between any two non-empty Intervals.
+type AllenRelation int
+
+const (
+	// AllenBefore means the Interval ends before the other one starts
+	AllenBefore AllenRelation = iota
+	// AllenMeets means the Interval ends exactly where the other one starts
+	AllenMeets
+	// AllenOverlaps means the Interval starts first and ends inside of the other one
+	AllenOverlaps
+	// AllenStarts means both Intervals start together and the Interval ends first
+	AllenStarts
+	// AllenDuring means the Interval lies inside of the other one without sharing start or end
+	AllenDuring
+	// AllenFinishes means both Intervals end together and the Interval starts last
+	AllenFinishes
+	// AllenEquals means both Intervals start and end together
+	AllenEquals
+	// AllenFinishedBy is the inverse of AllenFinishes
+	AllenFinishedBy
+	// AllenContains is the inverse of AllenDuring
+	AllenContains
+	// AllenStartedBy is the inverse of AllenStarts
+	AllenStartedBy
+	// AllenOverlappedBy is the inverse of AllenOverlaps
+	AllenOverlappedBy
+	// AllenMetBy is the inverse of AllenMeets
+	AllenMetBy
+	// AllenAfter is the inverse of AllenBefore
+	AllenAfter
+)
+
+var allenRelationNames = map[AllenRelation]string{
+	AllenBefore:       "before",
+	AllenMeets:        "meets",
+	AllenOverlaps:     "overlaps",
+	AllenStarts:       "starts",
+	AllenDuring:       "during",
+	AllenFinishes:     "finishes",
+	AllenEquals:       "equals",
+	AllenFinishedBy:   "finished-by",
+	AllenContains:     "contains",
+	AllenStartedBy:    "started-by",
+	AllenOverlappedBy: "overlapped-by",
+	AllenMetBy:        "met-by",
+	AllenAfter:        "after",
+}
+
+// String returns the name of the AllenRelation, for example "overlapped-by"
+func (r AllenRelation) String() string {
+	if name, ok := allenRelationNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("AllenRelation(%d)", int(r))
+}
+
+// Inverse returns the AllenRelation, which holds if both Intervals are swapped
+//
+// For Example:
+//
+//	AllenBefore becomes AllenAfter
+//	AllenEquals stays AllenEquals
+func (r AllenRelation) Inverse() AllenRelation {
+	return AllenAfter - r
+}
+
+// Relation returns the AllenRelation the Interval has to the other Interval
+//
+// For Example:
+//
+//	[10:00, 11:00) to [11:00, 12:00) becomes AllenMeets
+//	[10:00, 12:00) to [10:00, 11:00) becomes AllenStartedBy
+//
+// Empty Intervals are classified by their instants as well,
+// therefore an empty Interval at the start of another one meets it.
+func (i Interval) Relation(other Interval) AllenRelation {
+	startComparison := i.Start.Compare(other.Start)
+	endComparison := i.End.Compare(other.End)
+
+	switch {
+	case startComparison == 0 && endComparison == 0:
+		return AllenEquals
+	case i.End.IsBefore(other.Start):
+		return AllenBefore
+	case i.End.Equal(other.Start):
+		return AllenMeets
+	case i.Start.IsAfter(other.End):
+		return AllenAfter
+	case i.Start.Equal(other.End):
+		return AllenMetBy
+	case startComparison == 0 && endComparison < 0:
+		return AllenStarts
+	case startComparison == 0:
+		return AllenStartedBy
+	case endComparison == 0 && startComparison > 0:
+		return AllenFinishes
+	case endComparison == 0:
+		return AllenFinishedBy
+	case startComparison < 0 && endComparison < 0:
+		return AllenOverlaps
+	case startComparison < 0:
+		return AllenContains
+	case endComparison > 0:
+		return AllenOverlappedBy
+	}
+	return AllenDuring
+}
+
+// RelationIs checks if the Interval has one of the given AllenRelations to the other Interval
+//
+// For Example:
+//
+//	shift.RelationIs(workday, AllenStarts, AllenDuring, AllenFinishes, AllenEquals)
+//	checks if the shift lies completely within the workday
+func (i Interval) RelationIs(other Interval, relations ...AllenRelation) bool {
+	relation := i.Relation(other)
+	for _, candidate := range relations {
+		if candidate == relation {
+			return true
+		}
+	}
+	return false
+}
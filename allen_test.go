@@ -0,0 +1,63 @@
+package gostradamus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterval_Relation(t *testing.T) {
+	reference := minutes(10, 20)
+	cases := []struct {
+		interval Interval
+		expected AllenRelation
+	}{
+		{minutes(0, 5), AllenBefore},
+		{minutes(0, 10), AllenMeets},
+		{minutes(5, 15), AllenOverlaps},
+		{minutes(10, 15), AllenStarts},
+		{minutes(12, 18), AllenDuring},
+		{minutes(15, 20), AllenFinishes},
+		{minutes(10, 20), AllenEquals},
+		{minutes(5, 20), AllenFinishedBy},
+		{minutes(5, 25), AllenContains},
+		{minutes(10, 25), AllenStartedBy},
+		{minutes(15, 25), AllenOverlappedBy},
+		{minutes(20, 25), AllenMetBy},
+		{minutes(25, 30), AllenAfter},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, c.interval.Relation(reference), c.expected.String())
+		assert.Equal(t, c.expected.Inverse(), reference.Relation(c.interval), c.expected.String())
+	}
+}
+
+func TestInterval_Relation_Span(t *testing.T) {
+	day := NewUTCDateTime(2012, 12, 12, 12, 0, 0, 0).SpanInterval(UnitDay)
+	month := NewUTCDateTime(2012, 12, 12, 12, 0, 0, 0).SpanInterval(UnitMonth)
+	firstDay := NewUTCDateTime(2012, 12, 1, 0, 0, 0, 0).SpanInterval(UnitDay)
+	nextMonth := NewUTCDateTime(2013, 1, 12, 12, 0, 0, 0).SpanInterval(UnitMonth)
+
+	assert.Equal(t, AllenDuring, day.Relation(month))
+	assert.Equal(t, AllenStarts, firstDay.Relation(month))
+	assert.Equal(t, AllenMeets, month.Relation(nextMonth))
+}
+
+func TestInterval_RelationIs(t *testing.T) {
+	workday := minutes(9*60, 17*60)
+	assert.True(t, minutes(9*60, 12*60).RelationIs(workday, AllenStarts, AllenDuring, AllenFinishes, AllenEquals))
+	assert.False(t, minutes(8*60, 12*60).RelationIs(workday, AllenStarts, AllenDuring, AllenFinishes, AllenEquals))
+	assert.False(t, workday.RelationIs(workday))
+}
+
+func TestAllenRelation_String(t *testing.T) {
+	assert.Equal(t, "overlapped-by", AllenOverlappedBy.String())
+	assert.Equal(t, "AllenRelation(42)", AllenRelation(42).String())
+}
+
+func TestAllenRelation_Inverse(t *testing.T) {
+	assert.Equal(t, AllenAfter, AllenBefore.Inverse())
+	assert.Equal(t, AllenEquals, AllenEquals.Inverse())
+	assert.Equal(t, AllenStartedBy, AllenStarts.Inverse())
+	assert.Equal(t, AllenDuring, AllenContains.Inverse())
+}
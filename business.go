@@ -0,0 +1,220 @@
+package gostradamus
+
+import (
+	"slices"
+	"time"
+)
+
+// businessDaySearchDays limits how many days are searched for the next business day,
+// before a BusinessCalendar is considered to have no business days at all
+const businessDaySearchDays = 10 * 366
+
+// HolidayCalendar decides which days are holidays
+type HolidayCalendar interface {
+	// IsHoliday checks if the day of given DateTime is a holiday
+	IsHoliday(dateTime DateTime) bool
+}
+
+// HolidayFunc is a function, which implements HolidayCalendar
+type HolidayFunc func(dateTime DateTime) bool
+
+// IsHoliday calls the HolidayFunc
+func (f HolidayFunc) IsHoliday(dateTime DateTime) bool {
+	return f(dateTime)
+}
+
+// HolidaySet is a HolidayCalendar of single days
+type HolidaySet struct {
+	days map[civilDate]bool
+}
+
+// civilDate is a day in no particular timezone
+type civilDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// NewHolidaySet returns a new HolidaySet with the days of given DateTimes
+func NewHolidaySet(dateTimes ...DateTime) HolidaySet {
+	return HolidaySet{}.Add(dateTimes...)
+}
+
+// Add returns a new HolidaySet, which additionally contains the days of given DateTimes
+func (s HolidaySet) Add(dateTimes ...DateTime) HolidaySet {
+	days := make(map[civilDate]bool, len(s.days)+len(dateTimes))
+	for day := range s.days {
+		days[day] = true
+	}
+	for _, dateTime := range dateTimes {
+		days[civilDateOf(dateTime.Time())] = true
+	}
+	return HolidaySet{days: days}
+}
+
+// IsHoliday checks if the day of given DateTime, in its own timezone, is in the HolidaySet
+func (s HolidaySet) IsHoliday(dateTime DateTime) bool {
+	return s.days[civilDateOf(dateTime.Time())]
+}
+
+// WorkingHours is a range of the day, in which work is done.
+// Start and End are the wall clock durations since midnight, End is excluded.
+type WorkingHours struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// BusinessCalendar defines business days by weekend days and holidays and the working hours of business days
+type BusinessCalendar struct {
+	// Weekend are the weekdays, which are never business days
+	Weekend []time.Weekday
+	// Holidays are the days, which are no business days, nil means no holidays
+	Holidays HolidayCalendar
+	// WorkingHours are the ranges of a business day, which count for BusinessDuration
+	WorkingHours []WorkingHours
+	// Timezone is used to decide which day a DateTime is on and is the timezone of returned DateTimes.
+	// An empty Timezone uses the timezone of the given DateTimes.
+	Timezone Timezone
+}
+
+// NewBusinessCalendar returns a new BusinessCalendar with saturday and sunday as weekend,
+// no holidays and working hours from 09:00 to 17:00
+func NewBusinessCalendar() BusinessCalendar {
+	return BusinessCalendar{
+		Weekend:      []time.Weekday{time.Saturday, time.Sunday},
+		WorkingHours: []WorkingHours{{Start: 9 * time.Hour, End: 17 * time.Hour}},
+	}
+}
+
+// IsBusinessDay checks if the day of given DateTime is neither on a weekend nor a holiday
+func (bc BusinessCalendar) IsBusinessDay(dateTime DateTime) bool {
+	dateTime = bc.in(dateTime)
+	if slices.Contains(bc.Weekend, dateTime.Time().Weekday()) {
+		return false
+	}
+	return bc.Holidays == nil || !bc.Holidays.IsHoliday(dateTime)
+}
+
+// NextBusinessDay returns the DateTime on the next business day after given DateTime with the same wall clock
+//
+// NextBusinessDay panics if there is no business day within the next ten years
+func (bc BusinessCalendar) NextBusinessDay(dateTime DateTime) DateTime {
+	return bc.nearestBusinessDay(bc.in(dateTime), 1)
+}
+
+// PreviousBusinessDay returns the DateTime on the last business day before given DateTime with the same wall clock
+//
+// PreviousBusinessDay panics if there is no business day within the previous ten years
+func (bc BusinessCalendar) PreviousBusinessDay(dateTime DateTime) DateTime {
+	return bc.nearestBusinessDay(bc.in(dateTime), -1)
+}
+
+// AddBusinessDays returns the DateTime shifted by given business days with the same wall clock.
+// Every business day moves the DateTime to the next (or for negative days previous) business day,
+// therefore adding one business day to a saturday returns the following monday.
+//
+// For Example:
+//
+//	friday 2012-12-14 with 3 business days becomes wednesday 2012-12-19
+//
+// AddBusinessDays panics if there is no business day within ten years
+func (bc BusinessCalendar) AddBusinessDays(dateTime DateTime, days int) DateTime {
+	dateTime = bc.in(dateTime)
+	direction := 1
+	if days < 0 {
+		direction, days = -1, -days
+	}
+	for ; days > 0; days-- {
+		dateTime = bc.nearestBusinessDay(dateTime, direction)
+	}
+	return dateTime
+}
+
+// BusinessDaysBetween returns the count of business days from the day of start, included,
+// to the day of end, excluded. The count is negative if end is before start.
+//
+// For Example:
+//
+//	monday 2012-12-10 to monday 2012-12-17 becomes 5
+func (bc BusinessCalendar) BusinessDaysBetween(start DateTime, end DateTime) int {
+	if end.IsBefore(start) {
+		return -bc.BusinessDaysBetween(end, start)
+	}
+	start, end = bc.in(start), bc.in(end)
+
+	count := 0
+	last := civilDateOf(end.Time())
+	for day := bc.wallClock(start, 0); civilDateOf(day.Time()) != last; day = bc.shiftDays(day, 1) {
+		if bc.IsBusinessDay(day) {
+			count++
+		}
+	}
+	return count
+}
+
+// BusinessDuration returns the time between start and end, which lies within the working hours of business days.
+// The duration is negative if end is before start.
+//
+// For Example:
+//
+//	friday 2012-12-14 16:00 to monday 2012-12-17 10:00 becomes 2h with working hours from 09:00 to 17:00
+func (bc BusinessCalendar) BusinessDuration(start DateTime, end DateTime) time.Duration {
+	if end.IsBefore(start) {
+		return -bc.BusinessDuration(end, start)
+	}
+	start, end = bc.in(start), bc.in(end)
+	bound := NewInterval(start, end)
+
+	var workingIntervals []Interval
+	for day := bc.wallClock(start, 0); !day.IsAfter(end); day = bc.shiftDays(day, 1) {
+		if !bc.IsBusinessDay(day) {
+			continue
+		}
+		for _, workingHours := range bc.WorkingHours {
+			if interval, ok := bc.workingInterval(day, workingHours).Intersection(bound); ok {
+				workingIntervals = append(workingIntervals, interval)
+			}
+		}
+	}
+	// overlapping working hours count only once
+	return NewIntervalSet(workingIntervals...).Duration()
+}
+
+// in returns the DateTime in the Timezone of the BusinessCalendar
+func (bc BusinessCalendar) in(dateTime DateTime) DateTime {
+	if bc.Timezone == "" {
+		return dateTime
+	}
+	return dateTime.InTimezone(bc.Timezone)
+}
+
+// nearestBusinessDay returns the DateTime on the next business day in given direction with the same wall clock
+func (bc BusinessCalendar) nearestBusinessDay(dateTime DateTime, direction int) DateTime {
+	for i := 1; i <= businessDaySearchDays; i++ {
+		if day := bc.shiftDays(dateTime, i*direction); bc.IsBusinessDay(day) {
+			return day
+		}
+	}
+	panic(BusinessDayIsNotFound(dateTime))
+}
+
+// shiftDays returns the DateTime shifted by days with the same wall clock
+func (bc BusinessCalendar) shiftDays(dateTime DateTime, days int) DateTime {
+	return DateTimeFromTime(dateTime.Time().AddDate(0, 0, days))
+}
+
+// workingInterval returns the Interval of the WorkingHours on the day of given DateTime
+func (bc BusinessCalendar) workingInterval(day DateTime, workingHours WorkingHours) Interval {
+	start, end := bc.wallClock(day, workingHours.Start), bc.wallClock(day, workingHours.End)
+	return Interval{Start: start, End: Max(start, end)}
+}
+
+// wallClock returns the DateTime on the day of given DateTime at the wall clock duration since midnight
+func (bc BusinessCalendar) wallClock(day DateTime, sinceMidnight time.Duration) DateTime {
+	t := day.Time()
+	return DateTimeFromTime(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, int(sinceMidnight), t.Location()))
+}
+
+func civilDateOf(t time.Time) civilDate {
+	return civilDate{year: t.Year(), month: t.Month(), day: t.Day()}
+}
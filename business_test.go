@@ -0,0 +1,152 @@
+package gostradamus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHolidaySet(t *testing.T) {
+	holidays := NewHolidaySet(NewUTCDateTime(2012, 12, 25, 0, 0, 0, 0))
+	assert.True(t, holidays.IsHoliday(NewUTCDateTime(2012, 12, 25, 18, 0, 0, 0)))
+	assert.False(t, holidays.IsHoliday(NewUTCDateTime(2012, 12, 26, 0, 0, 0, 0)))
+
+	// Days are compared in the timezone of the DateTime
+	assert.True(t, holidays.IsHoliday(NewDateTime(2012, 12, 25, 0, 30, 0, 0, EuropeBerlin)))
+
+	extended := holidays.Add(NewUTCDateTime(2012, 12, 26, 0, 0, 0, 0))
+	assert.True(t, extended.IsHoliday(NewUTCDateTime(2012, 12, 26, 0, 0, 0, 0)))
+	assert.False(t, holidays.IsHoliday(NewUTCDateTime(2012, 12, 26, 0, 0, 0, 0)))
+	assert.False(t, HolidaySet{}.IsHoliday(NewUTCDateTime(2012, 12, 26, 0, 0, 0, 0)))
+}
+
+func TestBusinessCalendar_IsBusinessDay(t *testing.T) {
+	calendar := NewBusinessCalendar()
+	calendar.Holidays = NewHolidaySet(NewUTCDateTime(2012, 12, 25, 0, 0, 0, 0))
+
+	assert.True(t, calendar.IsBusinessDay(NewUTCDateTime(2012, 12, 14, 12, 0, 0, 0)))
+	assert.False(t, calendar.IsBusinessDay(NewUTCDateTime(2012, 12, 15, 12, 0, 0, 0)))
+	assert.False(t, calendar.IsBusinessDay(NewUTCDateTime(2012, 12, 16, 12, 0, 0, 0)))
+	assert.False(t, calendar.IsBusinessDay(NewUTCDateTime(2012, 12, 25, 12, 0, 0, 0)))
+
+	// friday and saturday weekend with a HolidayFunc
+	calendar.Weekend = []time.Weekday{time.Friday, time.Saturday}
+	calendar.Holidays = HolidayFunc(func(dateTime DateTime) bool {
+		return dateTime.Month() == 1 && dateTime.Day() == 1
+	})
+	assert.True(t, calendar.IsBusinessDay(NewUTCDateTime(2012, 12, 16, 12, 0, 0, 0)))
+	assert.False(t, calendar.IsBusinessDay(NewUTCDateTime(2012, 12, 14, 12, 0, 0, 0)))
+	assert.False(t, calendar.IsBusinessDay(NewUTCDateTime(2013, 1, 1, 12, 0, 0, 0)))
+
+	// The Timezone of the BusinessCalendar decides the day
+	calendar = NewBusinessCalendar()
+	calendar.Timezone = AsiaKathmandu
+	assert.False(t, calendar.IsBusinessDay(NewUTCDateTime(2012, 12, 14, 20, 0, 0, 0)))
+}
+
+func TestBusinessCalendar_NextBusinessDay(t *testing.T) {
+	calendar := NewBusinessCalendar()
+	calendar.Holidays = NewHolidaySet(NewUTCDateTime(2012, 12, 17, 0, 0, 0, 0))
+
+	actual := calendar.NextBusinessDay(NewUTCDateTime(2012, 12, 14, 12, 0, 0, 0))
+	assert.Equal(t, NewUTCDateTime(2012, 12, 18, 12, 0, 0, 0), actual)
+
+	actual = calendar.PreviousBusinessDay(NewUTCDateTime(2012, 12, 18, 12, 0, 0, 0))
+	assert.Equal(t, NewUTCDateTime(2012, 12, 14, 12, 0, 0, 0), actual)
+
+	// The wall clock is kept across daylight saving time transitions
+	actual = calendar.NextBusinessDay(NewDateTime(2012, 10, 26, 9, 0, 0, 0, EuropeBerlin))
+	assert.Equal(t, NewDateTime(2012, 10, 29, 9, 0, 0, 0, EuropeBerlin), actual)
+
+	calendar.Weekend = []time.Weekday{
+		time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday,
+	}
+	assert.PanicsWithError(
+		t,
+		"BusinessDay: no business day found near 2012-12-14T12:00:00.000000Z",
+		func() {
+			calendar.NextBusinessDay(NewUTCDateTime(2012, 12, 14, 12, 0, 0, 0))
+		},
+	)
+}
+
+func TestBusinessCalendar_AddBusinessDays(t *testing.T) {
+	calendar := NewBusinessCalendar()
+	calendar.Holidays = NewHolidaySet(NewUTCDateTime(2012, 12, 25, 0, 0, 0, 0), NewUTCDateTime(2012, 12, 26, 0, 0, 0, 0))
+
+	actual := calendar.AddBusinessDays(NewUTCDateTime(2012, 12, 14, 12, 0, 0, 0), 3)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 19, 12, 0, 0, 0), actual)
+
+	actual = calendar.AddBusinessDays(NewUTCDateTime(2012, 12, 21, 12, 0, 0, 0), 2)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 27, 12, 0, 0, 0), actual)
+
+	actual = calendar.AddBusinessDays(NewUTCDateTime(2012, 12, 27, 12, 0, 0, 0), -2)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 21, 12, 0, 0, 0), actual)
+
+	actual = calendar.AddBusinessDays(NewUTCDateTime(2012, 12, 15, 12, 0, 0, 0), 1)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 17, 12, 0, 0, 0), actual)
+
+	actual = calendar.AddBusinessDays(NewUTCDateTime(2012, 12, 15, 12, 0, 0, 0), 0)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 15, 12, 0, 0, 0), actual)
+
+	calendar.Timezone = EuropeBerlin
+	actual = calendar.AddBusinessDays(NewUTCDateTime(2012, 12, 14, 12, 0, 0, 0), 1)
+	assert.Equal(t, NewDateTime(2012, 12, 17, 13, 0, 0, 0, EuropeBerlin), actual)
+}
+
+func TestBusinessCalendar_BusinessDaysBetween(t *testing.T) {
+	calendar := NewBusinessCalendar()
+	calendar.Holidays = NewHolidaySet(NewUTCDateTime(2012, 12, 25, 0, 0, 0, 0))
+
+	monday := NewUTCDateTime(2012, 12, 10, 12, 0, 0, 0)
+	assert.Equal(t, 5, calendar.BusinessDaysBetween(monday, monday.ShiftDays(7)))
+	assert.Equal(t, -5, calendar.BusinessDaysBetween(monday.ShiftDays(7), monday))
+	assert.Equal(t, 0, calendar.BusinessDaysBetween(monday, monday.ShiftHours(6)))
+	assert.Equal(t, 9, calendar.BusinessDaysBetween(monday.ShiftDays(7), monday.ShiftDays(21)))
+	assert.Equal(t, 1, calendar.BusinessDaysBetween(monday.ShiftHours(11), monday.ShiftHours(13)))
+}
+
+func TestBusinessCalendar_BusinessDuration(t *testing.T) {
+	calendar := NewBusinessCalendar()
+
+	actual := calendar.BusinessDuration(
+		NewUTCDateTime(2012, 12, 14, 16, 0, 0, 0),
+		NewUTCDateTime(2012, 12, 17, 10, 0, 0, 0),
+	)
+	assert.Equal(t, 2*time.Hour, actual)
+
+	actual = calendar.BusinessDuration(
+		NewUTCDateTime(2012, 12, 17, 10, 0, 0, 0),
+		NewUTCDateTime(2012, 12, 14, 16, 0, 0, 0),
+	)
+	assert.Equal(t, -2*time.Hour, actual)
+
+	actual = calendar.BusinessDuration(
+		NewUTCDateTime(2012, 12, 10, 0, 0, 0, 0),
+		NewUTCDateTime(2012, 12, 17, 0, 0, 0, 0),
+	)
+	assert.Equal(t, 40*time.Hour, actual)
+
+	// Split and overlapping working hours
+	calendar.WorkingHours = []WorkingHours{
+		{Start: 8 * time.Hour, End: 12 * time.Hour},
+		{Start: 11 * time.Hour, End: 12 * time.Hour},
+		{Start: 13 * time.Hour, End: 17*time.Hour + 30*time.Minute},
+	}
+	actual = calendar.BusinessDuration(
+		NewUTCDateTime(2012, 12, 14, 11, 30, 0, 0),
+		NewUTCDateTime(2012, 12, 14, 20, 0, 0, 0),
+	)
+	assert.Equal(t, 5*time.Hour, actual)
+
+	// Working hours are wall clocks, even on days with daylight saving time transitions
+	calendar = NewBusinessCalendar()
+	calendar.Weekend = nil
+	calendar.WorkingHours = []WorkingHours{{Start: 0, End: 4 * time.Hour}}
+	actual = calendar.BusinessDuration(
+		NewDateTime(2012, 10, 28, 0, 0, 0, 0, EuropeBerlin),
+		NewDateTime(2012, 10, 29, 0, 0, 0, 0, EuropeBerlin),
+	)
+	assert.Equal(t, 5*time.Hour, actual)
+}
@@ -0,0 +1,158 @@
+package gostradamus
+
+import "slices"
+
+// Bounds defines whether start and end are included in a range, written in interval notation:
+// a square bracket includes, a parenthesis excludes the bound
+type Bounds string
+
+// All possible Bounds
+const (
+	// BoundsOpen excludes start and end
+	BoundsOpen = Bounds("()")
+	// BoundsClosed includes start and end
+	BoundsClosed = Bounds("[]")
+	// BoundsClosedOpen includes start and excludes end
+	BoundsClosedOpen = Bounds("[)")
+	// BoundsOpenClosed excludes start and includes end
+	BoundsOpenClosed = Bounds("(]")
+)
+
+// IsValid checks if the Bounds are one of the four possible Bounds
+func (b Bounds) IsValid() bool {
+	switch b {
+	case BoundsOpen, BoundsClosed, BoundsClosedOpen, BoundsOpenClosed:
+		return true
+	}
+	return false
+}
+
+// IncludesStart checks if the Bounds include the start of a range
+func (b Bounds) IncludesStart() bool {
+	return b == BoundsClosed || b == BoundsClosedOpen
+}
+
+// IncludesEnd checks if the Bounds include the end of a range
+func (b Bounds) IncludesEnd() bool {
+	return b == BoundsClosed || b == BoundsOpenClosed
+}
+
+// IsBetweenBounds checks if current DateTime is between start and end DateTimes,
+// including or excluding start and end as defined by bounds
+//
+// For Example:
+//
+//	start, end := dt.SpanDay()
+//	start.IsBetweenBounds(start, end, BoundsClosed) // true
+//
+// IsBetweenBounds panics if the bounds are not valid
+func (dt DateTime) IsBetweenBounds(start DateTime, end DateTime, bounds Bounds) bool {
+	if !bounds.IsValid() {
+		panic(BoundsAreInvalid(bounds))
+	}
+	afterStart := dt.IsAfter(start) || (bounds.IncludesStart() && dt.Equal(start))
+	beforeEnd := dt.IsBefore(end) || (bounds.IncludesEnd() && dt.Equal(end))
+	return afterStart && beforeEnd
+}
+
+// IsBetweenUnit checks if current DateTime is between start and end DateTimes with the granularity of given unit,
+// including or excluding start and end as defined by bounds.
+// Start and end are converted into the timezone of current DateTime first.
+//
+// For Example:
+//
+//	2012-12-12 12:00:00 is between 2012-12-12 18:00:00 and 2012-12-13 00:00:00 with UnitDay and BoundsClosed
+//
+// IsBetweenUnit panics if the unit is not supported or the bounds are not valid
+func (dt DateTime) IsBetweenUnit(start DateTime, end DateTime, unit Unit, bounds Bounds) bool {
+	return dt.Floor(unit).IsBetweenBounds(
+		dt.inTimezoneOf(start).Floor(unit),
+		dt.inTimezoneOf(end).Floor(unit),
+		bounds,
+	)
+}
+
+// IsBefore checks if current DateTime is before other DateTime
+func (dt DateTime) IsBefore(other DateTime) bool {
+	return dt.Time().Before(other.Time())
+}
+
+// IsAfter checks if current DateTime is after other DateTime
+func (dt DateTime) IsAfter(other DateTime) bool {
+	return dt.Time().After(other.Time())
+}
+
+// Equal checks if current DateTime and other DateTime are the same instant, even in different timezones
+func (dt DateTime) Equal(other DateTime) bool {
+	return dt.Time().Equal(other.Time())
+}
+
+// Compare returns -1 if current DateTime is before other DateTime,
+// +1 if it is after and 0 if both are the same instant.
+// It can be used with slices.SortFunc.
+func (dt DateTime) Compare(other DateTime) int {
+	return dt.Time().Compare(other.Time())
+}
+
+// IsSame checks if current DateTime and other DateTime are in the same span of given unit.
+// The other DateTime is converted into the timezone of current DateTime first.
+//
+// For Example:
+//
+//	2012-12-12 00:00:00 and 2012-12-12 23:59:59 are the same with UnitDay
+//
+// IsSame panics if the unit is not supported
+func (dt DateTime) IsSame(other DateTime, unit Unit) bool {
+	return dt.Floor(unit).Equal(dt.inTimezoneOf(other).Floor(unit))
+}
+
+// IsSameOrBefore checks if current DateTime is in the same span of given unit as other DateTime or before.
+// The other DateTime is converted into the timezone of current DateTime first.
+//
+// IsSameOrBefore panics if the unit is not supported
+func (dt DateTime) IsSameOrBefore(other DateTime, unit Unit) bool {
+	return !dt.Floor(unit).IsAfter(dt.inTimezoneOf(other).Floor(unit))
+}
+
+// IsSameOrAfter checks if current DateTime is in the same span of given unit as other DateTime or after.
+// The other DateTime is converted into the timezone of current DateTime first.
+//
+// IsSameOrAfter panics if the unit is not supported
+func (dt DateTime) IsSameOrAfter(other DateTime, unit Unit) bool {
+	return !dt.Floor(unit).IsBefore(dt.inTimezoneOf(other).Floor(unit))
+}
+
+// inTimezoneOf returns other DateTime in the location of current DateTime
+func (dt DateTime) inTimezoneOf(other DateTime) DateTime {
+	return DateTimeFromTime(other.Time().In(dt.Time().Location()))
+}
+
+// Min returns the earliest of the given DateTimes
+// If several DateTimes are the same instant, the first of them is returned
+func Min(first DateTime, others ...DateTime) DateTime {
+	earliest := first
+	for _, other := range others {
+		if other.IsBefore(earliest) {
+			earliest = other
+		}
+	}
+	return earliest
+}
+
+// Max returns the latest of the given DateTimes
+// If several DateTimes are the same instant, the first of them is returned
+func Max(first DateTime, others ...DateTime) DateTime {
+	latest := first
+	for _, other := range others {
+		if other.IsAfter(latest) {
+			latest = other
+		}
+	}
+	return latest
+}
+
+// Sort sorts the given DateTimes in place from the earliest to the latest
+// The order of DateTimes, which are the same instant, is kept
+func Sort(dateTimes []DateTime) {
+	slices.SortStableFunc(dateTimes, DateTime.Compare)
+}
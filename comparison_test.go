@@ -0,0 +1,168 @@
+package gostradamus
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateTime_IsBefore(t *testing.T) {
+	dateTime := NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0)
+	assert.True(t, dateTime.IsBefore(NewUTCDateTime(2020, 1, 1, 12, 0, 0, 1)))
+	assert.False(t, dateTime.IsBefore(NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0)))
+	assert.False(t, dateTime.IsBefore(NewUTCDateTime(2020, 1, 1, 11, 0, 0, 0)))
+}
+
+func TestDateTime_IsAfter(t *testing.T) {
+	dateTime := NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0)
+	assert.True(t, dateTime.IsAfter(NewUTCDateTime(2020, 1, 1, 11, 59, 59, 0)))
+	assert.False(t, dateTime.IsAfter(NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0)))
+	assert.False(t, dateTime.IsAfter(NewUTCDateTime(2020, 1, 1, 13, 0, 0, 0)))
+}
+
+func TestDateTime_Equal(t *testing.T) {
+	dateTime := NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0)
+	assert.True(t, dateTime.Equal(NewDateTime(2020, 1, 1, 13, 0, 0, 0, EuropeBerlin)))
+	assert.False(t, dateTime.Equal(NewDateTime(2020, 1, 1, 12, 0, 0, 0, EuropeBerlin)))
+}
+
+func TestDateTime_Compare(t *testing.T) {
+	dateTime := NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0)
+	assert.Equal(t, -1, dateTime.Compare(NewUTCDateTime(2020, 1, 1, 13, 0, 0, 0)))
+	assert.Equal(t, 0, dateTime.Compare(NewDateTime(2020, 1, 1, 13, 0, 0, 0, EuropeBerlin)))
+	assert.Equal(t, 1, dateTime.Compare(NewUTCDateTime(2020, 1, 1, 11, 0, 0, 0)))
+
+	dateTimes := []DateTime{
+		NewUTCDateTime(2020, 1, 3, 0, 0, 0, 0),
+		NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0),
+		NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0),
+	}
+	slices.SortFunc(dateTimes, DateTime.Compare)
+	assert.Equal(
+		t,
+		[]DateTime{
+			NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0),
+			NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0),
+			NewUTCDateTime(2020, 1, 3, 0, 0, 0, 0),
+		},
+		dateTimes,
+	)
+}
+
+func TestDateTime_IsSame(t *testing.T) {
+	dateTime := NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0)
+	assert.True(t, dateTime.IsSame(NewUTCDateTime(2020, 1, 1, 23, 59, 59, 999999999), UnitDay))
+	assert.False(t, dateTime.IsSame(NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0), UnitDay))
+	assert.True(t, dateTime.IsSame(NewUTCDateTime(2020, 3, 31, 0, 0, 0, 0), UnitQuarter))
+
+	// 2020-01-01 00:30:00 in Berlin is still 2019 in UTC
+	assert.False(t, dateTime.IsSame(NewDateTime(2020, 1, 1, 0, 30, 0, 0, EuropeBerlin), UnitYear))
+	assert.True(t, NewDateTime(2020, 1, 1, 0, 30, 0, 0, EuropeBerlin).IsSame(dateTime, UnitYear))
+}
+
+func TestDateTime_IsSameOrBefore(t *testing.T) {
+	dateTime := NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0)
+	assert.True(t, dateTime.IsSameOrBefore(NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0), UnitDay))
+	assert.True(t, dateTime.IsSameOrBefore(NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0), UnitDay))
+	assert.False(t, dateTime.IsSameOrBefore(NewUTCDateTime(2019, 12, 31, 23, 0, 0, 0), UnitDay))
+	assert.False(t, dateTime.IsSameOrBefore(NewUTCDateTime(2020, 1, 1, 11, 0, 0, 0), UnitNanosecond))
+}
+
+func TestDateTime_IsSameOrAfter(t *testing.T) {
+	dateTime := NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0)
+	assert.True(t, dateTime.IsSameOrAfter(NewUTCDateTime(2020, 1, 1, 23, 0, 0, 0), UnitDay))
+	assert.True(t, dateTime.IsSameOrAfter(NewUTCDateTime(2019, 12, 31, 0, 0, 0, 0), UnitDay))
+	assert.False(t, dateTime.IsSameOrAfter(NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0), UnitDay))
+}
+
+func TestMin(t *testing.T) {
+	actual := Min(
+		NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0),
+		NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0),
+		NewUTCDateTime(2020, 1, 3, 0, 0, 0, 0),
+	)
+	assert.Equal(t, NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0), actual)
+
+	actual = Min(NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0))
+	assert.Equal(t, NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0), actual)
+}
+
+func TestMax(t *testing.T) {
+	actual := Max(
+		NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0),
+		NewUTCDateTime(2020, 1, 3, 0, 0, 0, 0),
+		NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0),
+	)
+	assert.Equal(t, NewUTCDateTime(2020, 1, 3, 0, 0, 0, 0), actual)
+
+	// The first of equal instants is returned
+	actual = Max(
+		NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0),
+		NewDateTime(2020, 1, 1, 13, 0, 0, 0, EuropeBerlin),
+	)
+	assert.Equal(t, NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0), actual)
+}
+
+func TestSort(t *testing.T) {
+	dateTimes := []DateTime{
+		NewUTCDateTime(2020, 1, 3, 0, 0, 0, 0),
+		NewDateTime(2020, 1, 1, 1, 0, 0, 0, EuropeBerlin),
+		NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0),
+		NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0),
+	}
+	Sort(dateTimes)
+	assert.Equal(
+		t,
+		[]DateTime{
+			NewDateTime(2020, 1, 1, 1, 0, 0, 0, EuropeBerlin),
+			NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0),
+			NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0),
+			NewUTCDateTime(2020, 1, 3, 0, 0, 0, 0),
+		},
+		dateTimes,
+	)
+}
+
+func TestBounds_IsValid(t *testing.T) {
+	assert.True(t, BoundsOpen.IsValid())
+	assert.True(t, Bounds("[)").IsValid())
+	assert.False(t, Bounds("[[").IsValid())
+	assert.False(t, Bounds("").IsValid())
+}
+
+func TestDateTime_IsBetweenBounds(t *testing.T) {
+	start, end := NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0).SpanDay()
+
+	assert.True(t, start.IsBetweenBounds(start, end, BoundsClosed))
+	assert.True(t, end.IsBetweenBounds(start, end, BoundsClosed))
+	assert.True(t, start.IsBetweenBounds(start, end, BoundsClosedOpen))
+	assert.False(t, end.IsBetweenBounds(start, end, BoundsClosedOpen))
+	assert.False(t, start.IsBetweenBounds(start, end, BoundsOpenClosed))
+	assert.True(t, end.IsBetweenBounds(start, end, BoundsOpenClosed))
+	assert.False(t, start.IsBetweenBounds(start, end, BoundsOpen))
+	assert.False(t, end.IsBetweenBounds(start, end, BoundsOpen))
+	assert.True(t, NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0).IsBetweenBounds(start, end, "()"))
+	assert.False(t, NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0).IsBetweenBounds(start, end, "[]"))
+
+	assert.PanicsWithError(
+		t,
+		"Bounds: [[ are invalid",
+		func() {
+			start.IsBetweenBounds(start, end, "[[")
+		},
+	)
+}
+
+func TestDateTime_IsBetweenUnit(t *testing.T) {
+	dateTime := NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0)
+	start := NewUTCDateTime(2020, 1, 1, 18, 0, 0, 0)
+	end := NewUTCDateTime(2020, 1, 3, 0, 0, 0, 0)
+
+	assert.True(t, dateTime.IsBetweenUnit(start, end, UnitDay, BoundsClosed))
+	assert.True(t, dateTime.IsBetweenUnit(start, end, UnitDay, BoundsClosedOpen))
+	assert.False(t, dateTime.IsBetweenUnit(start, end, UnitDay, BoundsOpen))
+	assert.False(t, dateTime.IsBetweenUnit(start, end, UnitHour, BoundsClosed))
+	assert.True(t, NewUTCDateTime(2020, 1, 3, 23, 0, 0, 0).IsBetweenUnit(start, end, UnitDay, BoundsOpenClosed))
+	assert.False(t, NewUTCDateTime(2020, 1, 3, 23, 0, 0, 0).IsBetweenUnit(start, end, UnitDay, BoundsClosedOpen))
+}
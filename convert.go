@@ -0,0 +1,205 @@
+package gostradamus
+
+import (
+	"slices"
+	"strings"
+)
+
+// goLayoutElement is an element of a Go layout with its FormatToken, an empty FormatToken is not convertible
+type goLayoutElement struct {
+	layout string
+	token  FormatToken
+}
+
+// goLayoutElements are the elements of Go layouts, longer elements before their prefixes
+var goLayoutElements = []goLayoutElement{
+	{"January", MonthFull},
+	{"Jan", MonthAbbr},
+	{"Monday", DayOfWeekFullName},
+	{"Mon", DayOfWeekAbbr},
+	{"MST", TimezoneFullName},
+	{"2006", YearFull},
+	{"002", DayOfYearZeroPadded},
+	{"01", MonthZeroPadded},
+	{"02", DayOfMonthZeroPadded},
+	{"03", TwelveHourZeroPadded},
+	{"04", MinuteZeroPadded},
+	{"05", SecondZeroPadded},
+	{"06", YearShort},
+	{"15", TwentyFourHourZeroPadded},
+	{"1", MonthShort},
+	{"2", DayOfMonthShort},
+	{"3", TwelveHour},
+	{"4", Minute},
+	{"5", Second},
+	{"PM", AMPMUpper},
+	{"pm", AMPMLower},
+	{"__2", ""},
+	{"_2", ""},
+	{"Z07:00:00", ""},
+	{"Z070000", ""},
+	{"Z07:00", TimezoneWithColon},
+	{"Z0700", TimezoneWithoutColon},
+	{"Z07", ""},
+	{"-07:00:00", ""},
+	{"-070000", ""},
+	{"-07:00", TimezoneWithColon},
+	{"-0700", TimezoneWithoutColon},
+	{"-07", ""},
+}
+
+// FormatToGoLayout converts a gostradamus format to a Go layout of time.Format.
+// Localized FormatTokens are replaced by the formats of EnglishLocale.
+//
+// For Example:
+//
+//	"DD.MM.YYYY HH:mm" becomes "02.01.2006 15:04"
+//
+// error if a FormatToken has no equivalent Go layout, like Do or the week tokens,
+// or if a literal would be read as Go layout, like "Mon" in "[Mon] HH:mm"
+func FormatToGoLayout(format string) (string, error) {
+	elements := compileLayout(format, defaultLocale())
+	var builder strings.Builder
+	for _, element := range elements {
+		if element.token == "" {
+			builder.WriteString(element.literal)
+			continue
+		}
+		goFormatToken, ok := formatTokenMap[element.token]
+		if !ok || element.token == DayOfMonthOrdinal {
+			return "", FormatTokenIsNotConvertible(string(element.token))
+		}
+		builder.WriteString(string(goFormatToken))
+	}
+
+	layout := builder.String()
+	goElements, err := compileGoLayout(layout)
+	if err != nil || !slices.Equal(elements, goElements) {
+		return "", FormatIsNotConvertible(format)
+	}
+	return layout, nil
+}
+
+// GoLayoutToFormat converts a Go layout of time.Format to a gostradamus format
+//
+// For Example:
+//
+//	time.RFC3339 becomes "YYYY-MM-DDTHH:mm:sszz"
+//
+// Consider that -0700 and -07:00 become Z and zz, which format UTC as "Z".
+// error if an element has no equivalent FormatToken, like _2 or fractional seconds other than .000000
+func GoLayoutToFormat(layout string) (string, error) {
+	elements, err := compileGoLayout(layout)
+	if err != nil {
+		return "", err
+	}
+	return formatFromElements(elements), nil
+}
+
+// compileGoLayout splits a Go layout into FormatTokens and the literals between them like time.Format does
+func compileGoLayout(layout string) ([]layoutElement, error) {
+	var elements []layoutElement
+	literal := func(value string) {
+		if last := len(elements) - 1; last >= 0 && elements[last].token == "" {
+			elements[last].literal += value
+			return
+		}
+		elements = append(elements, layoutElement{literal: value})
+	}
+
+	for layout != "" {
+		// fractional seconds are a dot or comma followed by zeros or nines
+		if fraction := goLayoutFraction(layout); fraction != "" {
+			if fraction[1:] != string(GoMicrosecond) {
+				return nil, GoLayoutElementIsNotConvertible(fraction)
+			}
+			literal(fraction[:1])
+			elements = append(elements, layoutElement{token: MicroSecond})
+			layout = layout[len(fraction):]
+			continue
+		}
+
+		element, ok := goLayoutElementAt(layout)
+		if !ok {
+			literal(layout[:1])
+			layout = layout[1:]
+			continue
+		}
+		if element.token == "" {
+			return nil, GoLayoutElementIsNotConvertible(element.layout)
+		}
+		elements = append(elements, layoutElement{token: element.token})
+		layout = layout[len(element.layout):]
+	}
+	return elements, nil
+}
+
+// goLayoutElementAt returns the goLayoutElement at the start of layout
+func goLayoutElementAt(layout string) (goLayoutElement, bool) {
+	for _, element := range goLayoutElements {
+		if !strings.HasPrefix(layout, element.layout) {
+			continue
+		}
+		// like time.Format, "Jan" and "Mon" followed by a lower case letter are literals, like "Monkey"
+		rest := layout[len(element.layout):]
+		if (element.layout == "Jan" || element.layout == "Mon") && rest != "" && rest[0] >= 'a' && rest[0] <= 'z' {
+			continue
+		}
+		// like time.Format, "_2006" is an underscore followed by the year
+		if element.layout == "_2" && strings.HasPrefix(rest, "006") {
+			return goLayoutElement{}, false
+		}
+		return element, true
+	}
+	return goLayoutElement{}, false
+}
+
+// goLayoutFraction returns the fractional seconds at the start of layout, like ".000", or an empty string
+func goLayoutFraction(layout string) string {
+	if len(layout) < 2 || (layout[0] != '.' && layout[0] != ',') || (layout[1] != '0' && layout[1] != '9') {
+		return ""
+	}
+	end := 2
+	for end < len(layout) && layout[end] == layout[1] {
+		end++
+	}
+	if end < len(layout) && layout[end] >= '0' && layout[end] <= '9' {
+		return ""
+	}
+	return layout[:end]
+}
+
+// formatFromElements joins elements to a gostradamus format.
+// Literals are escaped if they could be read as FormatTokens and neighbouring FormatTokens are separated by "[]",
+// if they could be read as other FormatTokens.
+func formatFromElements(elements []layoutElement) string {
+	var builder strings.Builder
+	var previous FormatToken
+	for _, element := range elements {
+		if element.token == "" {
+			builder.WriteString(escapeLiteral(element.literal))
+			previous = ""
+			continue
+		}
+		if previous != "" && !slices.Equal(
+			compileLayoutElements(string(previous+element.token), defaultLocale(), false),
+			[]layoutElement{{token: previous}, {token: element.token}},
+		) {
+			builder.WriteString("[]")
+		}
+		builder.WriteString(string(element.token))
+		previous = element.token
+	}
+	return builder.String()
+}
+
+// escapeLiteral escapes literal in square brackets, if it could be read as FormatTokens or contains brackets
+func escapeLiteral(literal string) string {
+	parts := strings.Split(literal, "]")
+	for index, part := range parts {
+		if layoutRegexp.MatchString(part) || strings.Contains(part, "[") {
+			parts[index] = "[" + part + "]"
+		}
+	}
+	return strings.Join(parts, "]")
+}
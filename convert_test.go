@@ -0,0 +1,93 @@
+package gostradamus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatToGoLayout(t *testing.T) {
+	tests := map[string]string{
+		"DD.MM.YYYY HH:mm":             "02.01.2006 15:04",
+		"YYYY-MM-DDTHH:mm:sszz":        "2006-01-02T15:04:05Z07:00",
+		"ddd, D MMM YYYY HH:mm:ss ZZZ": "Mon, 2 Jan 2006 15:04:05 MST",
+		"dddd, MMMM D h:mm:ss.S a":     "Monday, January 2 3:04:05.000000 pm",
+		"YYYY-DDDD [at] hh:mm A Z":     "2006-002 at 03:04 PM Z0700",
+		"L":                            "01/02/2006",
+	}
+	for format, expected := range tests {
+		actual, err := FormatToGoLayout(format)
+		assert.NoError(t, err, format)
+		assert.Equal(t, expected, actual, format)
+	}
+
+	_, err := FormatToGoLayout("Do MMMM")
+	assert.EqualError(t, err, "FormatToken: Do is not convertible")
+
+	_, err = FormatToGoLayout("GGGG-WW")
+	assert.EqualError(t, err, "FormatToken: GGGG is not convertible")
+
+	_, err = FormatToGoLayout("[Mon] HH:mm")
+	assert.EqualError(t, err, "Format: [Mon] HH:mm is not convertible")
+
+	_, err = FormatToGoLayout("YYYY [2006]")
+	assert.EqualError(t, err, "Format: YYYY [2006] is not convertible")
+}
+
+func TestFormatToGoLayout_Format(t *testing.T) {
+	dateTime := NewDateTime(2011, 4, 5, 15, 7, 8, 123456000, AsiaKathmandu)
+	for _, format := range []string{
+		"DD.MM.YYYY HH:mm",
+		"ddd, D MMM YYYY h:mm:ss.S A zz",
+		"[Today is] dddd",
+	} {
+		layout, err := FormatToGoLayout(format)
+		assert.NoError(t, err, format)
+		assert.Equal(t, dateTime.Format(format), dateTime.Time().Format(layout), format)
+	}
+}
+
+func TestGoLayoutToFormat(t *testing.T) {
+	tests := map[string]string{
+		time.RFC3339:                      "YYYY-MM-DDTHH:mm:sszz",
+		time.RFC1123Z:                     "ddd, DD MMM YYYY HH:mm:ss Z",
+		time.Kitchen:                      "h:mmA",
+		time.DateTime:                     "YYYY-MM-DD HH:mm:ss",
+		"2006-01-02T15:04:05.000000Z0700": "YYYY-MM-DDTHH:mm:ss.SZ",
+		"02.01.2006 at 15:04":             "DD.MM.YYYY[ at ]HH:mm",
+		"Monkey Jan":                      "[Monkey ]MMM",
+		"0102":                            "MMDD",
+		"_2006":                           "_YYYY",
+		"Day 002 [pm]":                    "[Day ]DDDD[ []a]",
+	}
+	for layout, expected := range tests {
+		actual, err := GoLayoutToFormat(layout)
+		assert.NoError(t, err, layout)
+		assert.Equal(t, expected, actual, layout)
+	}
+
+	_, err := GoLayoutToFormat(time.ANSIC)
+	assert.EqualError(t, err, "GoLayout: _2 is not convertible")
+
+	_, err = GoLayoutToFormat(time.RFC3339Nano)
+	assert.EqualError(t, err, "GoLayout: .999999999 is not convertible")
+
+	_, err = GoLayoutToFormat("15:04 -07")
+	assert.EqualError(t, err, "GoLayout: -07 is not convertible")
+}
+
+func TestGoLayoutToFormat_Parse(t *testing.T) {
+	for _, layout := range []string{time.RFC3339, time.RFC1123Z, time.RFC850, "2006-01-02 3:04PM"} {
+		format, err := GoLayoutToFormat(layout)
+		assert.NoError(t, err, layout)
+
+		value := time.Date(2011, 4, 5, 15, 7, 8, 0, time.UTC).Format(layout)
+		expected, err := time.Parse(layout, value)
+		assert.NoError(t, err, layout)
+
+		actual, err := Parse(value, format)
+		assert.NoError(t, err, layout)
+		assert.True(t, expected.Equal(actual.Time()), layout)
+	}
+}
@@ -0,0 +1,442 @@
+package gostradamus
+
+import (
+	"iter"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchDays limits how many days Next and Prev search,
+// before a Cron is considered to never match, like "0 0 30 2 *"
+const cronSearchDays = 100 * 366
+
+// Cron is a parsed cron expression, which is evaluated in its timezone.
+// Use ParseCron or ParseCronInTimezone to create a Cron.
+type Cron struct {
+	expression string
+	location   *time.Location
+
+	seconds []int
+	minutes []int
+	hours   []int
+	months  uint64
+
+	daysOfMonth     uint64
+	lastDayOffsets  []int
+	nearestWeekdays []int
+	lastWeekday     bool
+	dayOfMonthStar  bool
+
+	daysOfWeek    uint64
+	nthWeekdays   []NthWeekday
+	dayOfWeekStar bool
+}
+
+// cronField defines the allowed values and names of a field of a cron expression
+type cronField struct {
+	minimum int
+	maximum int
+	names   map[string]int
+}
+
+var (
+	cronMacros = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+
+	cronSecondField     = cronField{minimum: 0, maximum: 59}
+	cronMinuteField     = cronField{minimum: 0, maximum: 59}
+	cronHourField       = cronField{minimum: 0, maximum: 23}
+	cronDayOfMonthField = cronField{minimum: 1, maximum: 31}
+	cronMonthField      = cronField{
+		minimum: 1,
+		maximum: 12,
+		names: map[string]int{
+			"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+			"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+		},
+	}
+	// cronDayOfWeekField accepts 0 and 7 for sunday
+	cronDayOfWeekField = cronField{
+		minimum: 0,
+		maximum: 7,
+		names: map[string]int{
+			"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+		},
+	}
+)
+
+// ParseCron parses a cron expression into a new Cron, which is evaluated in UTC
+//
+// Supported are five fields (minute, hour, day of month, month, day of week),
+// six fields with leading seconds and the macros @yearly, @annually, @monthly, @weekly, @daily, @midnight and @hourly.
+// Fields accept *, ?, lists, ranges, steps and the names JAN-DEC and SUN-SAT.
+// The day of month accepts L (last day), L-3 (third last day), 15W (nearest weekday to the 15th) and LW (last weekday).
+// The day of week accepts 5L (last friday) and 5#3 (third friday).
+//
+// If both day of month and day of week are restricted, a day matches if one of both matches,
+// like in Vixie cron. A field starting with * or ? is not restricted.
+func ParseCron(expression string) (Cron, error) {
+	return ParseCronInTimezone(expression, UTC)
+}
+
+// ParseCronInTimezone parses a cron expression like ParseCron into a new Cron, which is evaluated in given timezone
+func ParseCronInTimezone(expression string, timezone Timezone) (Cron, error) {
+	cron := Cron{expression: expression, location: timezone.Location()}
+
+	fields := strings.Fields(expression)
+	if len(fields) == 1 {
+		macro, ok := cronMacros[strings.ToLower(fields[0])]
+		if !ok {
+			return Cron{}, CronExpressionIsNotParsable(expression)
+		}
+		fields = strings.Fields(macro)
+	}
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return Cron{}, CronExpressionIsNotParsable(expression)
+	}
+
+	var err error
+	var seconds, minutes, hours uint64
+	if seconds, err = parseCronField(fields[0], cronSecondField); err != nil {
+		return Cron{}, CronExpressionIsNotParsable(expression)
+	}
+	if minutes, err = parseCronField(fields[1], cronMinuteField); err != nil {
+		return Cron{}, CronExpressionIsNotParsable(expression)
+	}
+	if hours, err = parseCronField(fields[2], cronHourField); err != nil {
+		return Cron{}, CronExpressionIsNotParsable(expression)
+	}
+	if err = cron.parseDayOfMonth(fields[3]); err != nil {
+		return Cron{}, CronExpressionIsNotParsable(expression)
+	}
+	if cron.months, err = parseCronField(fields[4], cronMonthField); err != nil {
+		return Cron{}, CronExpressionIsNotParsable(expression)
+	}
+	if err = cron.parseDayOfWeek(fields[5]); err != nil {
+		return Cron{}, CronExpressionIsNotParsable(expression)
+	}
+
+	cron.seconds = bitsToValues(seconds)
+	cron.minutes = bitsToValues(minutes)
+	cron.hours = bitsToValues(hours)
+	return cron, nil
+}
+
+// String returns the cron expression the Cron was parsed from
+func (c Cron) String() string {
+	return c.expression
+}
+
+// Next returns the first DateTime after given DateTime the Cron matches, in the timezone of the Cron
+// The returned bool is false if the Cron never matches again.
+//
+// Wall clocks, which do not exist because of a daylight saving time gap, are skipped,
+// wall clocks, which exist twice because of a daylight saving time overlap, match only once.
+func (c Cron) Next(after DateTime) (DateTime, bool) {
+	start := after.Time().In(c.location)
+	date := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < cronSearchDays; i++ {
+		day := date.AddDate(0, 0, i)
+		if !c.matchesDay(day) {
+			continue
+		}
+		for _, hour := range c.hours {
+			if i == 0 && hour < start.Hour() {
+				continue
+			}
+			for _, minute := range c.minutes {
+				if i == 0 && hour == start.Hour() && minute < start.Minute() {
+					continue
+				}
+				for _, second := range c.seconds {
+					if candidate, ok := c.candidate(day, hour, minute, second); ok && candidate.After(start) {
+						return DateTimeFromTime(candidate), true
+					}
+				}
+			}
+		}
+	}
+	return DateTime{}, false
+}
+
+// Prev returns the last DateTime before given DateTime the Cron matches, in the timezone of the Cron
+// The returned bool is false if the Cron never matched before.
+// Daylight saving time transitions are handled like in Next.
+func (c Cron) Prev(before DateTime) (DateTime, bool) {
+	start := before.Time().In(c.location)
+	date := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < cronSearchDays; i++ {
+		day := date.AddDate(0, 0, -i)
+		if !c.matchesDay(day) {
+			continue
+		}
+		for h := len(c.hours) - 1; h >= 0; h-- {
+			hour := c.hours[h]
+			if i == 0 && hour > start.Hour() {
+				continue
+			}
+			for m := len(c.minutes) - 1; m >= 0; m-- {
+				minute := c.minutes[m]
+				if i == 0 && hour == start.Hour() && minute > start.Minute() {
+					continue
+				}
+				for s := len(c.seconds) - 1; s >= 0; s-- {
+					if candidate, ok := c.candidate(day, hour, minute, c.seconds[s]); ok && candidate.Before(start) {
+						return DateTimeFromTime(candidate), true
+					}
+				}
+			}
+		}
+	}
+	return DateTime{}, false
+}
+
+// Iterate returns an iterator over all DateTimes after given DateTime the Cron matches
+func (c Cron) Iterate(after DateTime) iter.Seq[DateTime] {
+	return func(yield func(DateTime) bool) {
+		for {
+			next, ok := c.Next(after)
+			if !ok || !yield(next) {
+				return
+			}
+			after = next
+		}
+	}
+}
+
+// candidate returns the time of the wall clock on given day in the timezone of the Cron
+// The returned bool is false if the wall clock does not exist on that day.
+func (c Cron) candidate(day time.Time, hour int, minute int, second int) (time.Time, bool) {
+	candidate := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, c.location)
+	return candidate, candidate.Hour() == hour && candidate.Minute() == minute
+}
+
+// matchesDay checks if the day, given as UTC midnight, matches month, day of month and day of week
+func (c Cron) matchesDay(day time.Time) bool {
+	if c.months&(1<<uint(day.Month())) == 0 {
+		return false
+	}
+	lastDay := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	dayOfMonth := c.matchesDayOfMonth(day, lastDay)
+	dayOfWeek := c.matchesDayOfWeek(day, lastDay)
+	if c.dayOfMonthStar || c.dayOfWeekStar {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+func (c Cron) matchesDayOfMonth(day time.Time, lastDay int) bool {
+	if c.daysOfMonth&(1<<uint(day.Day())) != 0 {
+		return true
+	}
+	for _, offset := range c.lastDayOffsets {
+		if lastDay-offset == day.Day() {
+			return true
+		}
+	}
+	for _, target := range c.nearestWeekdays {
+		if nearestWeekday(day, target, lastDay) == day.Day() {
+			return true
+		}
+	}
+	return c.lastWeekday && nearestWeekday(day, lastDay, lastDay) == day.Day()
+}
+
+func (c Cron) matchesDayOfWeek(day time.Time, lastDay int) bool {
+	if c.daysOfWeek&(1<<uint(day.Weekday())) != 0 {
+		return true
+	}
+	for _, weekday := range c.nthWeekdays {
+		if weekday.Weekday != day.Weekday() {
+			continue
+		}
+		if weekday.N > 0 && (day.Day()-1)/WeekInDays+1 == weekday.N {
+			return true
+		}
+		if weekday.N < 0 && day.Day()+WeekInDays > lastDay {
+			return true
+		}
+	}
+	return false
+}
+
+// nearestWeekday returns the day of month of the weekday nearest to target in the month of day,
+// without leaving the month. It returns 0 if the month has no such day.
+func nearestWeekday(day time.Time, target int, lastDay int) int {
+	if target > lastDay {
+		return 0
+	}
+	switch time.Date(day.Year(), day.Month(), target, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if target == 1 {
+			return target + 2
+		}
+		return target - 1
+	case time.Sunday:
+		if target == lastDay {
+			return target - 2
+		}
+		return target + 1
+	}
+	return target
+}
+
+func (c *Cron) parseDayOfMonth(value string) error {
+	c.dayOfMonthStar = strings.HasPrefix(value, "*") || strings.HasPrefix(value, "?")
+	for _, item := range strings.Split(strings.ToUpper(value), ",") {
+		switch {
+		case item == "LW":
+			c.lastWeekday = true
+		case item == "L":
+			c.lastDayOffsets = append(c.lastDayOffsets, 0)
+		case strings.HasPrefix(item, "L-"):
+			offset, err := parseCronNumber(item[2:], cronField{minimum: 0, maximum: 30})
+			if err != nil {
+				return err
+			}
+			c.lastDayOffsets = append(c.lastDayOffsets, offset)
+		case strings.HasSuffix(item, "W"):
+			target, err := parseCronNumber(strings.TrimSuffix(item, "W"), cronDayOfMonthField)
+			if err != nil {
+				return err
+			}
+			c.nearestWeekdays = append(c.nearestWeekdays, target)
+		default:
+			days, err := parseCronItem(item, cronDayOfMonthField)
+			if err != nil {
+				return err
+			}
+			c.daysOfMonth |= days
+		}
+	}
+	return nil
+}
+
+func (c *Cron) parseDayOfWeek(value string) error {
+	c.dayOfWeekStar = strings.HasPrefix(value, "*") || strings.HasPrefix(value, "?")
+	for _, item := range strings.Split(strings.ToUpper(value), ",") {
+		weekdayValue, nValue, isNth := strings.Cut(item, "#")
+		switch {
+		case isNth:
+			weekday, err := parseCronNumber(weekdayValue, cronDayOfWeekField)
+			if err != nil {
+				return err
+			}
+			n, err := parseCronNumber(nValue, cronField{minimum: 1, maximum: 5})
+			if err != nil {
+				return err
+			}
+			c.nthWeekdays = append(c.nthWeekdays, NthWeekday{Weekday: time.Weekday(weekday % WeekInDays), N: n})
+		case len(item) > 1 && strings.HasSuffix(item, "L"):
+			weekday, err := parseCronNumber(strings.TrimSuffix(item, "L"), cronDayOfWeekField)
+			if err != nil {
+				return err
+			}
+			c.nthWeekdays = append(c.nthWeekdays, NthWeekday{Weekday: time.Weekday(weekday % WeekInDays), N: -1})
+		default:
+			weekdays, err := parseCronItem(item, cronDayOfWeekField)
+			if err != nil {
+				return err
+			}
+			// 7 is sunday as well
+			if weekdays&(1<<7) != 0 {
+				weekdays = weekdays&^(1<<7) | 1
+			}
+			c.daysOfWeek |= weekdays
+		}
+	}
+	return nil
+}
+
+// parseCronField parses a comma separated list of items into a bitset
+func parseCronField(value string, field cronField) (uint64, error) {
+	var values uint64
+	for _, item := range strings.Split(strings.ToUpper(value), ",") {
+		itemValues, err := parseCronItem(item, field)
+		if err != nil {
+			return 0, err
+		}
+		values |= itemValues
+	}
+	return values, nil
+}
+
+// parseCronItem parses an item like *, ?, 5, 1-5, */15 or 10-40/10 into a bitset
+func parseCronItem(item string, field cronField) (uint64, error) {
+	rangeValue, stepValue, hasStep := strings.Cut(item, "/")
+	step := 1
+	if hasStep {
+		var err error
+		step, err = parseCronNumber(stepValue, cronField{minimum: 1, maximum: field.maximum})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	first, last := field.minimum, field.maximum
+	if rangeValue != "*" && rangeValue != "?" {
+		firstValue, lastValue, isRange := strings.Cut(rangeValue, "-")
+		var err error
+		if first, err = parseCronNumber(firstValue, field); err != nil {
+			return 0, err
+		}
+		switch {
+		case isRange:
+			if last, err = parseCronNumber(lastValue, field); err != nil {
+				return 0, err
+			}
+		case !hasStep:
+			last = first
+		}
+	}
+	if first > last {
+		return 0, CronExpressionIsNotParsable(item)
+	}
+
+	var values uint64
+	for value := first; value <= last; value += step {
+		values |= 1 << uint(value)
+	}
+	return values, nil
+}
+
+// parseCronNumber parses a number or a name of the field
+func parseCronNumber(value string, field cronField) (int, error) {
+	number, ok := field.names[value]
+	if !ok {
+		var err error
+		if number, err = strconv.Atoi(value); err != nil {
+			return 0, CronExpressionIsNotParsable(value)
+		}
+	}
+	if number < field.minimum || number > field.maximum {
+		return 0, CronExpressionIsNotParsable(value)
+	}
+	return number, nil
+}
+
+// bitsToValues returns the values of the set bits in ascending order
+func bitsToValues(values uint64) []int {
+	result := make([]int, 0, bits.OnesCount64(values))
+	for values != 0 {
+		value := bits.TrailingZeros64(values)
+		result = append(result, value)
+		values &^= 1 << uint(value)
+	}
+	return result
+}
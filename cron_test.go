@@ -0,0 +1,222 @@
+package gostradamus
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// nextRuns returns the next count DateTimes of the cron expression after given DateTime
+func nextRuns(t *testing.T, expression string, timezone Timezone, after DateTime, count int) []DateTime {
+	cron, err := ParseCronInTimezone(expression, timezone)
+	assert.NoError(t, err)
+	var runs []DateTime
+	for run := range cron.Iterate(after) {
+		runs = append(runs, run)
+		if len(runs) == count {
+			break
+		}
+	}
+	return runs
+}
+
+func TestParseCron(t *testing.T) {
+	for _, expression := range []string{
+		"* * * * *",
+		"*/15 9-17 * * MON-FRI",
+		"0 0 12 ? * WED",
+		"30 */5 0 1,15 JAN-jun ?",
+		"0 0 L * *",
+		"0 0 L-2,15W,LW * *",
+		"0 0 * * 5L,FRI#3,7",
+		"@hourly",
+		"@ANNUALLY",
+	} {
+		actual, err := ParseCron(expression)
+		assert.NoError(t, err, expression)
+		assert.Equal(t, expression, actual.String())
+	}
+
+	for _, expression := range []string{
+		"",
+		"* * * *",
+		"* * * * * * *",
+		"@fortnightly",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * 32 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"* * * * FOO",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"* * * * L",
+		"* * * * 5#6",
+		"* * 32W * *",
+		"* * L-31 * *",
+		"a * * * *",
+	} {
+		_, err := ParseCron(expression)
+		assert.Equal(t, CronExpressionIsNotParsable(expression), err, expression)
+	}
+}
+
+func TestCron_Next(t *testing.T) {
+	after := NewUTCDateTime(2012, 12, 12, 12, 12, 12, 0)
+
+	actual := nextRuns(t, "*/15 9-17 * * MON-FRI", UTC, after, 3)
+	assert.Equal(
+		t,
+		[]DateTime{
+			NewUTCDateTime(2012, 12, 12, 12, 15, 0, 0),
+			NewUTCDateTime(2012, 12, 12, 12, 30, 0, 0),
+			NewUTCDateTime(2012, 12, 12, 12, 45, 0, 0),
+		},
+		actual,
+	)
+
+	// Friday evening continues on monday morning
+	actual = nextRuns(t, "0 9-17 * * MON-FRI", UTC, NewUTCDateTime(2012, 12, 14, 17, 0, 0, 0), 1)
+	assert.Equal(t, []DateTime{NewUTCDateTime(2012, 12, 17, 9, 0, 0, 0)}, actual)
+
+	// Six fields start with seconds
+	actual = nextRuns(t, "*/20 * * * * *", UTC, after, 3)
+	assert.Equal(
+		t,
+		[]DateTime{
+			NewUTCDateTime(2012, 12, 12, 12, 12, 20, 0),
+			NewUTCDateTime(2012, 12, 12, 12, 12, 40, 0),
+			NewUTCDateTime(2012, 12, 12, 12, 13, 0, 0),
+		},
+		actual,
+	)
+
+	actual = nextRuns(t, "@yearly", UTC, after, 2)
+	assert.Equal(t, []DateTime{NewUTCDateTime(2013, 1, 1, 0, 0, 0, 0), NewUTCDateTime(2014, 1, 1, 0, 0, 0, 0)}, actual)
+
+	actual = nextRuns(t, "0 0 29 2 *", UTC, after, 2)
+	assert.Equal(t, []DateTime{NewUTCDateTime(2016, 2, 29, 0, 0, 0, 0), NewUTCDateTime(2020, 2, 29, 0, 0, 0, 0)}, actual)
+
+	// The result is in the timezone of the Cron
+	actual = nextRuns(t, "0 9 * * *", EuropeBerlin, after, 1)
+	assert.Equal(t, []DateTime{NewDateTime(2012, 12, 13, 9, 0, 0, 0, EuropeBerlin)}, actual)
+
+	cron, err := ParseCron("0 0 30 2 *")
+	assert.NoError(t, err)
+	_, ok := cron.Next(after)
+	assert.False(t, ok)
+}
+
+func TestCron_Next_DayOfMonthAndDayOfWeek(t *testing.T) {
+	after := NewUTCDateTime(2012, 12, 1, 0, 0, 0, 0)
+
+	// Both restricted: the 13th or every friday
+	actual := nextRuns(t, "0 0 13 * FRI", UTC, after, 4)
+	assert.Equal(
+		t,
+		[]DateTime{
+			NewUTCDateTime(2012, 12, 7, 0, 0, 0, 0),
+			NewUTCDateTime(2012, 12, 13, 0, 0, 0, 0),
+			NewUTCDateTime(2012, 12, 14, 0, 0, 0, 0),
+			NewUTCDateTime(2012, 12, 21, 0, 0, 0, 0),
+		},
+		actual,
+	)
+
+	// A field starting with * restricts both
+	actual = nextRuns(t, "0 0 */2 * FRI", UTC, after, 2)
+	assert.Equal(t, []DateTime{NewUTCDateTime(2012, 12, 7, 0, 0, 0, 0), NewUTCDateTime(2012, 12, 21, 0, 0, 0, 0)}, actual)
+
+	actual = nextRuns(t, "0 0 L * ?", UTC, after, 3)
+	assert.Equal(
+		t,
+		[]DateTime{
+			NewUTCDateTime(2012, 12, 31, 0, 0, 0, 0),
+			NewUTCDateTime(2013, 1, 31, 0, 0, 0, 0),
+			NewUTCDateTime(2013, 2, 28, 0, 0, 0, 0),
+		},
+		actual,
+	)
+
+	actual = nextRuns(t, "0 0 L-1 * ?", UTC, after, 1)
+	assert.Equal(t, []DateTime{NewUTCDateTime(2012, 12, 30, 0, 0, 0, 0)}, actual)
+
+	// 2013-03-31 is a sunday, 2012-12-01 a saturday and 2013-09-15 a sunday
+	actual = nextRuns(t, "0 0 LW * ?", UTC, NewUTCDateTime(2013, 3, 1, 0, 0, 0, 0), 1)
+	assert.Equal(t, []DateTime{NewUTCDateTime(2013, 3, 29, 0, 0, 0, 0)}, actual)
+	actual = nextRuns(t, "0 0 1W * ?", UTC, NewUTCDateTime(2012, 11, 30, 0, 0, 0, 0), 1)
+	assert.Equal(t, []DateTime{NewUTCDateTime(2012, 12, 3, 0, 0, 0, 0)}, actual)
+	actual = nextRuns(t, "0 0 15W * ?", UTC, NewUTCDateTime(2013, 9, 1, 0, 0, 0, 0), 1)
+	assert.Equal(t, []DateTime{NewUTCDateTime(2013, 9, 16, 0, 0, 0, 0)}, actual)
+
+	actual = nextRuns(t, "0 0 ? * FRI#3", UTC, after, 2)
+	assert.Equal(t, []DateTime{NewUTCDateTime(2012, 12, 21, 0, 0, 0, 0), NewUTCDateTime(2013, 1, 18, 0, 0, 0, 0)}, actual)
+
+	actual = nextRuns(t, "0 0 ? * 5L", UTC, after, 2)
+	assert.Equal(t, []DateTime{NewUTCDateTime(2012, 12, 28, 0, 0, 0, 0), NewUTCDateTime(2013, 1, 25, 0, 0, 0, 0)}, actual)
+
+	// 7 is sunday as well
+	actual = nextRuns(t, "0 0 * * 7", UTC, after, 1)
+	assert.Equal(t, []DateTime{NewUTCDateTime(2012, 12, 2, 0, 0, 0, 0)}, actual)
+}
+
+func TestCron_Next_DaylightSavingTime(t *testing.T) {
+	// 02:30 does not exist on 2012-03-25 in Berlin
+	actual := nextRuns(t, "30 2 * * *", EuropeBerlin, NewDateTime(2012, 3, 24, 12, 0, 0, 0, EuropeBerlin), 1)
+	assert.Equal(t, []DateTime{NewDateTime(2012, 3, 26, 2, 30, 0, 0, EuropeBerlin)}, actual)
+
+	// 02:00 and 02:30 exist twice on 2012-10-28 in Berlin, but match only once
+	actual = nextRuns(t, "*/30 2 * * *", EuropeBerlin, NewDateTime(2012, 10, 28, 0, 0, 0, 0, EuropeBerlin), 3)
+	assert.Equal(t, 30*time.Minute, actual[1].Time().Sub(actual[0].Time()))
+	assert.Equal(t, NewDateTime(2012, 10, 29, 2, 0, 0, 0, EuropeBerlin), actual[2])
+
+	actual = nextRuns(t, "0 * * * *", EuropeBerlin, NewDateTime(2012, 10, 28, 1, 30, 0, 0, EuropeBerlin), 3)
+	hours := make([]int, len(actual))
+	for i, run := range actual {
+		hours[i] = run.Hour()
+	}
+	assert.Equal(t, []int{2, 3, 4}, hours)
+}
+
+func TestCron_Prev(t *testing.T) {
+	cron, err := ParseCron("*/15 9-17 * * MON-FRI")
+	assert.NoError(t, err)
+
+	actual, ok := cron.Prev(NewUTCDateTime(2012, 12, 12, 12, 15, 0, 0))
+	assert.True(t, ok)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 0, 0, 0), actual)
+
+	// Monday morning continues on friday evening
+	actual, ok = cron.Prev(NewUTCDateTime(2012, 12, 17, 9, 0, 0, 0))
+	assert.True(t, ok)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 14, 17, 45, 0, 0), actual)
+
+	cron, err = ParseCronInTimezone("0 0 L * *", EuropeBerlin)
+	assert.NoError(t, err)
+	actual, ok = cron.Prev(NewUTCDateTime(2013, 3, 15, 0, 0, 0, 0))
+	assert.True(t, ok)
+	assert.Equal(t, NewDateTime(2013, 2, 28, 0, 0, 0, 0, EuropeBerlin), actual)
+
+	cron, err = ParseCron("0 0 31 2 *")
+	assert.NoError(t, err)
+	_, ok = cron.Prev(NewUTCDateTime(2013, 3, 15, 0, 0, 0, 0))
+	assert.False(t, ok)
+}
+
+func TestCron_Iterate(t *testing.T) {
+	cron, err := ParseCron("@daily")
+	assert.NoError(t, err)
+
+	var actual []DateTime
+	for run := range cron.Iterate(NewUTCDateTime(2012, 12, 30, 12, 0, 0, 0)) {
+		if run.Year() > 2012 {
+			break
+		}
+		actual = append(actual, run)
+	}
+	assert.Equal(t, []DateTime{NewUTCDateTime(2012, 12, 31, 0, 0, 0, 0)}, actual)
+	assert.True(t, slices.IsSortedFunc(nextRuns(t, "*/7 * * * *", UTC, UTCNow(), 20), DateTime.Compare))
+}
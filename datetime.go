@@ -111,6 +111,38 @@ func (dt DateTime) Year() int {
 	return dt.Time().Year()
 }
 
+// Century of current DateTime as int
+// Centuries start with the year ending on 1, so 2000 is in the 20th and 2001 is in the 21st century.
+// The years -99 to 0 are in the century 0.
+func (dt DateTime) Century() int {
+	return floorDiv(dt.Year()-1, 100) + 1
+}
+
+// Decade of current DateTime as int, which is the first year of the decade
+// For Example: 2012 is in the decade 2010 and -5 is in the decade -10
+func (dt DateTime) Decade() int {
+	return floorDiv(dt.Year(), 10) * 10
+}
+
+// floorDiv divides a by b and rounds the result down, also for negative a
+func floorDiv(a int, b int) int {
+	quotient := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		quotient--
+	}
+	return quotient
+}
+
+// HalfYear of current DateTime as int, 1 for January to June and 2 for July to December
+func (dt DateTime) HalfYear() int {
+	return (dt.Month()-1)/6 + 1
+}
+
+// Quarter of current DateTime as int from 1 to 4
+func (dt DateTime) Quarter() int {
+	return (dt.Month()-1)/3 + 1
+}
+
 // Month of current DateTime as int
 func (dt DateTime) Month() int {
 	return int(dt.Time().Month())
@@ -249,6 +281,34 @@ func (dt DateTime) ShiftYears(years int) DateTime {
 	return DateTimeFromTime(dt.Time().AddDate(years, 0, 0))
 }
 
+// ShiftCenturies adds or subtracts centuries
+// Add is a positive integer
+// Subtract is a negative integer
+func (dt DateTime) ShiftCenturies(centuries int) DateTime {
+	return dt.ShiftYears(centuries * 100)
+}
+
+// ShiftDecades adds or subtracts decades
+// Add is a positive integer
+// Subtract is a negative integer
+func (dt DateTime) ShiftDecades(decades int) DateTime {
+	return dt.ShiftYears(decades * 10)
+}
+
+// ShiftHalfYears adds or subtracts half years
+// Add is a positive integer
+// Subtract is a negative integer
+func (dt DateTime) ShiftHalfYears(halfYears int) DateTime {
+	return dt.ShiftMonths(halfYears * 6)
+}
+
+// ShiftQuarters adds or subtracts quarters
+// Add is a positive integer
+// Subtract is a negative integer
+func (dt DateTime) ShiftQuarters(quarters int) DateTime {
+	return dt.ShiftMonths(quarters * 3)
+}
+
 // ShiftMonths adds or subtracts months
 // Add is a positive integer
 // Subtract is a negative integer
@@ -331,6 +391,26 @@ func (dt DateTime) Shift(years int, months int, days int, hours int, minutes int
 		ShiftNanoseconds(nanoseconds)
 }
 
+// FloorCentury returns a DateTime with all values to "floor" of current DateTime's century
+//
+// For Example:
+//
+//      2012-12-12 12:12:12.123456789 becomes 2001-01-01 00:00:00.00000000
+//
+func (dt DateTime) FloorCentury() DateTime {
+	return NewDateTime((dt.Century()-1)*100+1, 1, 1, 0, 0, 0, 0, dt.Timezone())
+}
+
+// FloorDecade returns a DateTime with all values to "floor" of current DateTime's decade
+//
+// For Example:
+//
+//      2012-12-12 12:12:12.123456789 becomes 2010-01-01 00:00:00.00000000
+//
+func (dt DateTime) FloorDecade() DateTime {
+	return NewDateTime(dt.Decade(), 1, 1, 0, 0, 0, 0, dt.Timezone())
+}
+
 // FloorYear returns a DateTime with all values to "floor" except year
 //
 // For Example:
@@ -341,6 +421,26 @@ func (dt DateTime) FloorYear() DateTime {
 	return dt.Replace(dt.Year(), 1, 1, 0, 0, 0, 0)
 }
 
+// FloorHalfYear returns a DateTime with all values to "floor" of current DateTime's half year
+//
+// For Example:
+//
+//      2012-12-12 12:12:12.123456789 becomes 2012-07-01 00:00:00.00000000
+//
+func (dt DateTime) FloorHalfYear() DateTime {
+	return NewDateTime(dt.Year(), (dt.HalfYear()-1)*6+1, 1, 0, 0, 0, 0, dt.Timezone())
+}
+
+// FloorQuarter returns a DateTime with all values to "floor" of current DateTime's quarter
+//
+// For Example:
+//
+//      2012-12-12 12:12:12.123456789 becomes 2012-10-01 00:00:00.00000000
+//
+func (dt DateTime) FloorQuarter() DateTime {
+	return NewDateTime(dt.Year(), (dt.Quarter()-1)*3+1, 1, 0, 0, 0, 0, dt.Timezone())
+}
+
 // FloorMonth returns a DateTime with all values to "floor" except year, month
 //
 // For Example:
@@ -352,24 +452,25 @@ func (dt DateTime) FloorMonth() DateTime {
 }
 
 // FloorWeek returns a DateTime with all values to "floor" of current DateTime's week except year, month
+// The week starts on the first day of the DefaultWeekCalendar, which is monday if not set otherwise
 //
 // For Example:
 //
 //     2012-12-12 12:12:12.123456789 (wednesday) becomes 2012-12-10 00:00:00.000000000 (monday)
 //
 func (dt DateTime) FloorWeek() DateTime {
-	deltaWeekdays := int(dt.WeekDay() - time.Monday)
-	// currentWeekDay is already monday
-	if deltaWeekdays == 0 {
-		return dt.FloorDay()
-	}
-
-	if deltaWeekdays == -1 {
-		return dt.ShiftDays(-6).FloorDay()
-	}
-
-	return dt.ShiftDays(-deltaWeekdays).FloorDay()
+	return dt.FloorWeekStartingOn(DefaultWeekCalendar().FirstDay)
+}
 
+// FloorWeekStartingOn returns a DateTime with all values to "floor" of current DateTime's week,
+// which starts on the given weekday
+//
+// For Example:
+//
+//     2012-12-12 12:12:12.123456789 (wednesday) with time.Sunday becomes 2012-12-09 00:00:00.000000000 (sunday)
+//
+func (dt DateTime) FloorWeekStartingOn(weekday time.Weekday) DateTime {
+	return dt.ShiftDays(-daysSinceWeekday(dt.WeekDay(), weekday)).FloorDay()
 }
 
 // FloorDay returns a DateTime with all values to "floor" except year, month, day
@@ -412,6 +513,26 @@ func (dt DateTime) FloorSecond() DateTime {
 	return dt.Replace(dt.Year(), dt.Month(), dt.Day(), dt.Hour(), dt.Minute(), dt.Second(), 0)
 }
 
+// CeilCentury returns a DateTime with all values to "ceil" of current DateTime's century
+//
+// For Example:
+//
+//     2012-05-12 12:12:12.123456789 becomes 2100-12-31 23:59:59.999999999
+//
+func (dt DateTime) CeilCentury() DateTime {
+	return NewDateTime(dt.Century()*100, 12, 31, 23, 59, 59, 999999999, dt.Timezone())
+}
+
+// CeilDecade returns a DateTime with all values to "ceil" of current DateTime's decade
+//
+// For Example:
+//
+//     2012-05-12 12:12:12.123456789 becomes 2019-12-31 23:59:59.999999999
+//
+func (dt DateTime) CeilDecade() DateTime {
+	return NewDateTime(dt.Decade()+9, 12, 31, 23, 59, 59, 999999999, dt.Timezone())
+}
+
 // CeilYear returns a DateTime with all values to "ceil" except year
 //
 // For Example:
@@ -422,6 +543,28 @@ func (dt DateTime) CeilYear() DateTime {
 	return dt.Replace(dt.Year(), 12, 31, 23, 59, 59, 999999999)
 }
 
+// CeilHalfYear returns a DateTime with all values to "ceil" of current DateTime's half year
+//
+// For Example:
+//
+//     2012-05-12 12:12:12.123456789 becomes 2012-06-30 23:59:59.999999999
+//
+func (dt DateTime) CeilHalfYear() DateTime {
+	// day 0 of the following month is the last day of the half year
+	return NewDateTime(dt.Year(), dt.HalfYear()*6+1, 0, 23, 59, 59, 999999999, dt.Timezone())
+}
+
+// CeilQuarter returns a DateTime with all values to "ceil" of current DateTime's quarter
+//
+// For Example:
+//
+//     2012-05-12 12:12:12.123456789 becomes 2012-06-30 23:59:59.999999999
+//
+func (dt DateTime) CeilQuarter() DateTime {
+	// day 0 of the following month is the last day of the quarter
+	return NewDateTime(dt.Year(), dt.Quarter()*3+1, 0, 23, 59, 59, 999999999, dt.Timezone())
+}
+
 // CeilMonth returns a DateTime with all values to "ceil" except year, month
 //
 // For Example:
@@ -437,18 +580,25 @@ func (dt DateTime) CeilMonth() DateTime {
 }
 
 // CeilWeek returns a DateTime with all values to "ceil" of current DateTime's week except year, month
+// The week starts on the first day of the DefaultWeekCalendar, which is monday if not set otherwise
 //
 // For Example:
 //
 //     2012-12-12 12:12:12.123456789 (wednesday) becomes 2012-12-16 23:59:59:123456789 (sunday)
 //
 func (dt DateTime) CeilWeek() DateTime {
-	currentWeekDay := int(dt.WeekDay())
-	if currentWeekDay == 0 {
-		return dt.CeilDay()
-	}
+	return dt.CeilWeekStartingOn(DefaultWeekCalendar().FirstDay)
+}
 
-	return dt.ShiftDays(WeekInDays - currentWeekDay).CeilDay()
+// CeilWeekStartingOn returns a DateTime with all values to "ceil" of current DateTime's week,
+// which starts on the given weekday
+//
+// For Example:
+//
+//     2012-12-12 12:12:12.123456789 (wednesday) with time.Sunday becomes 2012-12-15 23:59:59:123456789 (saturday)
+//
+func (dt DateTime) CeilWeekStartingOn(weekday time.Weekday) DateTime {
+	return dt.ShiftDays(WeekInDays - 1 - daysSinceWeekday(dt.WeekDay(), weekday)).CeilDay()
 }
 
 // CeilDay returns a DateTime with all values to "ceil" except year, month, day, minute, second
@@ -491,6 +641,26 @@ func (dt DateTime) CeilSecond() DateTime {
 	return dt.Replace(dt.Year(), dt.Month(), dt.Day(), dt.Hour(), dt.Minute(), dt.Second(), 999999999)
 }
 
+// SpanCentury returns the start and end DateTime of current century span
+//
+// For Example:
+//
+//     2012-05-12 12:12:12:123456789 becomes (2001-01-01 00:00:00.000000000, 2100-12-31 23:59:59.999999999)
+//
+func (dt DateTime) SpanCentury() (DateTime, DateTime) {
+	return dt.FloorCentury(), dt.CeilCentury()
+}
+
+// SpanDecade returns the start and end DateTime of current decade span
+//
+// For Example:
+//
+//     2012-05-12 12:12:12:123456789 becomes (2010-01-01 00:00:00.000000000, 2019-12-31 23:59:59.999999999)
+//
+func (dt DateTime) SpanDecade() (DateTime, DateTime) {
+	return dt.FloorDecade(), dt.CeilDecade()
+}
+
 // SpanYear returns the start and end DateTime of current year span
 //
 // For Example:
@@ -501,6 +671,26 @@ func (dt DateTime) SpanYear() (DateTime, DateTime) {
 	return dt.FloorYear(), dt.CeilYear()
 }
 
+// SpanHalfYear returns the start and end DateTime of current half year span
+//
+// For Example:
+//
+//     2012-05-12 12:12:12:123456789 becomes (2012-01-01 00:00:00.000000000, 2012-06-30 23:59:59.999999999)
+//
+func (dt DateTime) SpanHalfYear() (DateTime, DateTime) {
+	return dt.FloorHalfYear(), dt.CeilHalfYear()
+}
+
+// SpanQuarter returns the start and end DateTime of current quarter span
+//
+// For Example:
+//
+//     2012-05-12 12:12:12:123456789 becomes (2012-04-01 00:00:00.000000000, 2012-06-30 23:59:59.999999999)
+//
+func (dt DateTime) SpanQuarter() (DateTime, DateTime) {
+	return dt.FloorQuarter(), dt.CeilQuarter()
+}
+
 // SpanMonth returns the start and end DateTime of current month span
 //
 // For Example:
@@ -521,6 +711,16 @@ func (dt DateTime) SpanWeek() (DateTime, DateTime) {
 	return dt.FloorWeek(), dt.CeilWeek()
 }
 
+// SpanWeekStartingOn returns the start and end DateTime of current week span, which starts on the given weekday
+//
+// For Example:
+//
+//     2012-12-12 12:12:12.123456789 with time.Sunday becomes (2012-12-09 00:00:00.00000000, 2012-12-15 23:59:59.999999999)
+//
+func (dt DateTime) SpanWeekStartingOn(weekday time.Weekday) (DateTime, DateTime) {
+	return dt.FloorWeekStartingOn(weekday), dt.CeilWeekStartingOn(weekday)
+}
+
 // SpanDay returns the start and end DateTime of current day span
 //
 // For Example:
@@ -566,6 +766,16 @@ func (dt DateTime) WeekDay() time.Weekday {
 	return dt.Time().Weekday()
 }
 
+// Week returns the week-numbering year and the week number of current DateTime in the DefaultWeekCalendar
+func (dt DateTime) Week() (int, int) {
+	return DefaultWeekCalendar().Week(dt)
+}
+
+// IsoWeek returns the ISO-8601 year and week number of current DateTime
+func (dt DateTime) IsoWeek() (int, int) {
+	return dt.Time().ISOWeek()
+}
+
 // ReplaceYear will set the year of current DateTime and returns a new DateTime
 func (dt DateTime) ReplaceYear(year int) DateTime {
 	return NewDateTime(
@@ -676,6 +886,7 @@ func (dt DateTime) Replace(year int, month int, day int, hour int, minute int, s
 }
 
 // IsBetween checks if current DateTime is between start and end DateTimes
+// Start and end are excluded, use IsBetweenBounds to include them
 func (dt DateTime) IsBetween(start DateTime, end DateTime) bool {
 	return dt.Time().After(start.Time()) && dt.Time().Before(end.Time())
 }
@@ -26,6 +26,55 @@ func TestParseInTimezone(t *testing.T) {
 	)
 }
 
+func TestParse_FractionalSecond(t *testing.T) {
+	actual, err := Parse("2012-12-12 12:00:00.123", "YYYY-MM-DD HH:mm:ss")
+	assert.NoError(t, err)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 0, 0, 123000000), actual)
+
+	actual, err = Parse("12:00:00,5 PM", "hh:mm:ss A")
+	assert.NoError(t, err)
+	assert.Equal(t, NewUTCDateTime(0, 1, 1, 12, 0, 0, 500000000), actual)
+
+	_, err = ParseStrict("2012-12-12 12:00:00.123", "YYYY-MM-DD HH:mm:ss")
+	assert.EqualError(t, err, `Value: "2012-12-12 12:00:00.123" is not parsable as "YYYY-MM-DD HH:mm:ss", ".123" is left over`)
+}
+
+func TestParseInTimezone_ZoneName(t *testing.T) {
+	tests := []string{
+		"2012-12-12 12:00:00 CEST",
+		"2012-07-12 12:00:00 CEST",
+		"2012-12-12 12:00:00 CET",
+		"2012-12-12 12:00:00 UTC",
+		"2012-12-12 12:00:00 GMT",
+		"2012-12-12 12:00:00 GMT+3",
+		"2012-12-12 12:00:00 ChST",
+		"2012-12-12 12:00:00 +03",
+		"2012-12-12 12:00:00 AEST",
+	}
+	for _, value := range tests {
+		expected, err := time.ParseInLocation("2006-01-02 15:04:05 MST", value, EuropeBerlin.Location())
+		assert.NoError(t, err, value)
+
+		actual, err := ParseInTimezone(value, "YYYY-MM-DD HH:mm:ss ZZZ", EuropeBerlin)
+		assert.NoError(t, err, value)
+		assert.True(t, expected.Equal(actual.Time()), value)
+		assert.Equal(t, expected.Location().String(), actual.Time().Location().String(), value)
+	}
+
+	actual, err := ParseInTimezone("2012-12-12 12:00:00 CEST", "YYYY-MM-DD HH:mm:ss ZZZ", EuropeBerlin)
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2012, 12, 12, 11, 0, 0, 0, EuropeBerlin), actual)
+}
+
+func TestParse_Zulu(t *testing.T) {
+	for _, format := range []string{"YYYY-MM-DDTHH:mm:ssZ", "YYYY-MM-DDTHH:mm:sszz"} {
+		actual, err := ParseInTimezone("2012-12-12T12:00:00Z", format, EuropeBerlin)
+		assert.NoError(t, err, format)
+		assert.Equal(t, time.UTC, actual.Time().Location(), format)
+		assert.Equal(t, "UTC", actual.Format("ZZZ"), format)
+	}
+}
+
 func TestDateTime_ShiftMilliSeconds(t *testing.T) {
 	dateTime := NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0).ShiftMilliSeconds(10)
 	assert.Equal(
@@ -356,3 +405,180 @@ func TestDateTime_CTimeFormat(t *testing.T) {
 		actual,
 	)
 }
+
+func TestDateTime_Quarter(t *testing.T) {
+	assert.Equal(t, 1, NewUTCDateTime(2012, 3, 31, 0, 0, 0, 0).Quarter())
+	assert.Equal(t, 2, NewUTCDateTime(2012, 4, 1, 0, 0, 0, 0).Quarter())
+	assert.Equal(t, 4, NewUTCDateTime(2012, 12, 12, 0, 0, 0, 0).Quarter())
+}
+
+func TestDateTime_HalfYear(t *testing.T) {
+	assert.Equal(t, 1, NewUTCDateTime(2012, 6, 30, 0, 0, 0, 0).HalfYear())
+	assert.Equal(t, 2, NewUTCDateTime(2012, 7, 1, 0, 0, 0, 0).HalfYear())
+}
+
+func TestDateTime_Decade(t *testing.T) {
+	assert.Equal(t, 2010, NewUTCDateTime(2012, 12, 12, 0, 0, 0, 0).Decade())
+	assert.Equal(t, 2020, NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0).Decade())
+	assert.Equal(t, 0, NewUTCDateTime(0, 1, 1, 0, 0, 0, 0).Decade())
+	assert.Equal(t, -10, NewUTCDateTime(-5, 1, 1, 0, 0, 0, 0).Decade())
+	assert.Equal(t, -10, NewUTCDateTime(-10, 1, 1, 0, 0, 0, 0).Decade())
+}
+
+func TestDateTime_Century(t *testing.T) {
+	assert.Equal(t, 20, NewUTCDateTime(2000, 12, 31, 0, 0, 0, 0).Century())
+	assert.Equal(t, 21, NewUTCDateTime(2001, 1, 1, 0, 0, 0, 0).Century())
+	assert.Equal(t, 21, NewUTCDateTime(2012, 12, 12, 0, 0, 0, 0).Century())
+	assert.Equal(t, 1, NewUTCDateTime(1, 1, 1, 0, 0, 0, 0).Century())
+	assert.Equal(t, 0, NewUTCDateTime(0, 1, 1, 0, 0, 0, 0).Century())
+	assert.Equal(t, 0, NewUTCDateTime(-99, 1, 1, 0, 0, 0, 0).Century())
+	assert.Equal(t, -1, NewUTCDateTime(-100, 1, 1, 0, 0, 0, 0).Century())
+}
+
+func TestDateTime_ShiftQuarters(t *testing.T) {
+	actual := NewUTCDateTime(2012, 11, 30, 12, 0, 0, 0).ShiftQuarters(1)
+	assert.Equal(t, NewUTCDateTime(2013, 3, 2, 12, 0, 0, 0), actual)
+
+	actual = NewUTCDateTime(2012, 5, 15, 12, 0, 0, 0).ShiftQuarters(-2)
+	assert.Equal(t, NewUTCDateTime(2011, 11, 15, 12, 0, 0, 0), actual)
+}
+
+func TestDateTime_ShiftHalfYears(t *testing.T) {
+	actual := NewUTCDateTime(2012, 5, 15, 12, 0, 0, 0).ShiftHalfYears(3)
+	assert.Equal(t, NewUTCDateTime(2013, 11, 15, 12, 0, 0, 0), actual)
+}
+
+func TestDateTime_ShiftDecades(t *testing.T) {
+	actual := NewUTCDateTime(2012, 5, 15, 12, 0, 0, 0).ShiftDecades(-1)
+	assert.Equal(t, NewUTCDateTime(2002, 5, 15, 12, 0, 0, 0), actual)
+}
+
+func TestDateTime_ShiftCenturies(t *testing.T) {
+	actual := NewUTCDateTime(2012, 5, 15, 12, 0, 0, 0).ShiftCenturies(1)
+	assert.Equal(t, NewUTCDateTime(2112, 5, 15, 12, 0, 0, 0), actual)
+}
+
+func TestDateTime_FloorQuarter(t *testing.T) {
+	actual := NewUTCDateTime(2012, 5, 31, 12, 12, 49, 234).FloorQuarter()
+	assert.Equal(t, NewUTCDateTime(2012, 4, 1, 0, 0, 0, 0), actual)
+
+	actual = NewUTCDateTime(2012, 12, 12, 12, 12, 49, 234).FloorQuarter()
+	assert.Equal(t, NewUTCDateTime(2012, 10, 1, 0, 0, 0, 0), actual)
+}
+
+func TestDateTime_FloorHalfYear(t *testing.T) {
+	actual := NewUTCDateTime(2012, 12, 31, 12, 12, 49, 234).FloorHalfYear()
+	assert.Equal(t, NewUTCDateTime(2012, 7, 1, 0, 0, 0, 0), actual)
+
+	actual = NewUTCDateTime(2012, 6, 30, 12, 12, 49, 234).FloorHalfYear()
+	assert.Equal(t, NewUTCDateTime(2012, 1, 1, 0, 0, 0, 0), actual)
+}
+
+func TestDateTime_FloorDecade(t *testing.T) {
+	actual := NewUTCDateTime(2019, 12, 31, 12, 12, 49, 234).FloorDecade()
+	assert.Equal(t, NewUTCDateTime(2010, 1, 1, 0, 0, 0, 0), actual)
+
+	actual = NewUTCDateTime(-5, 6, 1, 12, 0, 0, 0).FloorDecade()
+	assert.Equal(t, NewUTCDateTime(-10, 1, 1, 0, 0, 0, 0), actual)
+}
+
+func TestDateTime_FloorCentury(t *testing.T) {
+	actual := NewUTCDateTime(2012, 12, 31, 12, 12, 49, 234).FloorCentury()
+	assert.Equal(t, NewUTCDateTime(2001, 1, 1, 0, 0, 0, 0), actual)
+
+	actual = NewUTCDateTime(2000, 12, 31, 12, 12, 49, 234).FloorCentury()
+	assert.Equal(t, NewUTCDateTime(1901, 1, 1, 0, 0, 0, 0), actual)
+
+	// "HH:mm" is parsed to year 0
+	dateTime, err := Parse("14:30", "HH:mm")
+	assert.NoError(t, err)
+	assert.Equal(t, NewUTCDateTime(-99, 1, 1, 0, 0, 0, 0), dateTime.FloorCentury())
+	assert.False(t, dateTime.FloorCentury().IsAfter(dateTime))
+}
+
+func TestDateTime_CeilQuarter(t *testing.T) {
+	actual := NewUTCDateTime(2012, 1, 31, 12, 12, 49, 234).CeilQuarter()
+	assert.Equal(t, NewUTCDateTime(2012, 3, 31, 23, 59, 59, 999999999), actual)
+
+	actual = NewUTCDateTime(2012, 11, 30, 12, 12, 49, 234).CeilQuarter()
+	assert.Equal(t, NewUTCDateTime(2012, 12, 31, 23, 59, 59, 999999999), actual)
+}
+
+func TestDateTime_CeilHalfYear(t *testing.T) {
+	actual := NewUTCDateTime(2012, 1, 31, 12, 12, 49, 234).CeilHalfYear()
+	assert.Equal(t, NewUTCDateTime(2012, 6, 30, 23, 59, 59, 999999999), actual)
+
+	actual = NewUTCDateTime(2012, 7, 31, 12, 12, 49, 234).CeilHalfYear()
+	assert.Equal(t, NewUTCDateTime(2012, 12, 31, 23, 59, 59, 999999999), actual)
+}
+
+func TestDateTime_CeilDecade(t *testing.T) {
+	actual := NewUTCDateTime(2010, 1, 1, 0, 0, 0, 0).CeilDecade()
+	assert.Equal(t, NewUTCDateTime(2019, 12, 31, 23, 59, 59, 999999999), actual)
+
+	actual = NewUTCDateTime(-5, 6, 1, 12, 0, 0, 0).CeilDecade()
+	assert.Equal(t, NewUTCDateTime(-1, 12, 31, 23, 59, 59, 999999999), actual)
+}
+
+func TestDateTime_CeilCentury(t *testing.T) {
+	actual := NewUTCDateTime(2100, 12, 31, 0, 0, 0, 0).CeilCentury()
+	assert.Equal(t, NewUTCDateTime(2100, 12, 31, 23, 59, 59, 999999999), actual)
+
+	actual = NewUTCDateTime(0, 1, 1, 14, 30, 0, 0).CeilCentury()
+	assert.Equal(t, NewUTCDateTime(0, 12, 31, 23, 59, 59, 999999999), actual)
+}
+
+func TestDateTime_SpanQuarter(t *testing.T) {
+	start, end := NewUTCDateTime(2020, 2, 15, 12, 12, 49, 234).SpanQuarter()
+	assert.Equal(t, NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0), start)
+	assert.Equal(t, NewUTCDateTime(2020, 3, 31, 23, 59, 59, 999999999), end)
+}
+
+func TestDateTime_SpanHalfYear(t *testing.T) {
+	start, end := NewUTCDateTime(2020, 8, 15, 12, 12, 49, 234).SpanHalfYear()
+	assert.Equal(t, NewUTCDateTime(2020, 7, 1, 0, 0, 0, 0), start)
+	assert.Equal(t, NewUTCDateTime(2020, 12, 31, 23, 59, 59, 999999999), end)
+}
+
+func TestDateTime_SpanDecade(t *testing.T) {
+	start, end := NewUTCDateTime(2020, 8, 15, 12, 12, 49, 234).SpanDecade()
+	assert.Equal(t, NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0), start)
+	assert.Equal(t, NewUTCDateTime(2029, 12, 31, 23, 59, 59, 999999999), end)
+}
+
+func TestDateTime_SpanCentury(t *testing.T) {
+	start, end := NewUTCDateTime(2020, 8, 15, 12, 12, 49, 234).SpanCentury()
+	assert.Equal(t, NewUTCDateTime(2001, 1, 1, 0, 0, 0, 0), start)
+	assert.Equal(t, NewUTCDateTime(2100, 12, 31, 23, 59, 59, 999999999), end)
+}
+
+func TestDateTime_FloorWeekStartingOn(t *testing.T) {
+	actual := NewUTCDateTime(2012, 12, 12, 12, 12, 49, 234).FloorWeekStartingOn(time.Sunday)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 9, 0, 0, 0, 0), actual)
+
+	actual = NewUTCDateTime(2012, 12, 15, 12, 12, 49, 234).FloorWeekStartingOn(time.Saturday)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 15, 0, 0, 0, 0), actual)
+
+	actual = NewUTCDateTime(2012, 12, 14, 12, 12, 49, 234).FloorWeekStartingOn(time.Saturday)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 8, 0, 0, 0, 0), actual)
+}
+
+func TestDateTime_CeilWeekStartingOn(t *testing.T) {
+	actual := NewUTCDateTime(2012, 12, 12, 12, 12, 49, 234).CeilWeekStartingOn(time.Sunday)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 15, 23, 59, 59, 999999999), actual)
+
+	actual = NewUTCDateTime(2012, 12, 14, 12, 12, 49, 234).CeilWeekStartingOn(time.Saturday)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 14, 23, 59, 59, 999999999), actual)
+}
+
+func TestDateTime_SpanWeekStartingOn(t *testing.T) {
+	start, end := NewUTCDateTime(2012, 12, 12, 12, 12, 49, 234).SpanWeekStartingOn(time.Saturday)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 8, 0, 0, 0, 0), start)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 14, 23, 59, 59, 999999999), end)
+}
+
+func TestDateTime_IsoWeek(t *testing.T) {
+	year, week := NewUTCDateTime(2012, 12, 31, 12, 12, 49, 234).IsoWeek()
+	assert.Equal(t, 2013, year)
+	assert.Equal(t, 1, week)
+}
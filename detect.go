@@ -0,0 +1,240 @@
+package gostradamus
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseAnyOptions configure ParseAny and DetectFormat
+type ParseAnyOptions struct {
+	// DayFirst prefers the day before the month in numeric dates separated by "/" or "-",
+	// like "02/01/2019" for January 2nd. Numeric dates separated by "." always have the day first.
+	DayFirst bool
+	// Timezone of values without an offset and of unix timestamps, empty is UTC
+	Timezone Timezone
+}
+
+// unixFormat is the pseudo format of unix timestamps in given unit with minDigits to maxDigits digits
+type unixFormat struct {
+	format    string
+	minDigits int
+	maxDigits int
+	unit      time.Duration
+}
+
+// unixFormats are ordered by precision, the count of digits decides which one a timestamp has.
+// Timestamps in seconds need 9 digits, so short numbers, like years, are not taken as timestamps.
+var unixFormats = []unixFormat{
+	{format: Unix, minDigits: 9, maxDigits: 10, unit: time.Second},
+	{format: UnixMilli, minDigits: 11, maxDigits: 13, unit: time.Millisecond},
+	{format: UnixMicro, minDigits: 14, maxDigits: 16, unit: time.Microsecond},
+	{format: UnixNano, minDigits: 17, maxDigits: 19, unit: time.Nanosecond},
+}
+
+// detectFormatsMonthFirst and detectFormatsDayFirst are the compiled formats of ParseAny in order of priority
+var (
+	detectFormatsMonthFirst = compileDetectFormats(false)
+	detectFormatsDayFirst   = compileDetectFormats(true)
+)
+
+// ParseAny a string value in one of the common formats into a new DateTime and returns the matched format.
+// The formats are tried in this order:
+// ISO 8601, RFC 2822, ctime, dates with month names, numeric dates and unix timestamps.
+// Numeric dates and dates with month names may be followed by a time, like "15:07", "15:07:08" or "3:07 PM".
+// Seconds may have a fraction of 1 to 9 digits, like ".123" of JavaScript's toISOString.
+// Unix timestamps return the pseudo formats Unix, UnixMilli, UnixMicro and UnixNano by their count of digits,
+// timestamps in seconds need at least 9 digits.
+//
+// For Example:
+//
+//	"Sat, 19 Jan 2019 18:26:50 +0100" matches Rfc2822
+//	"19.01.2019 18:26" matches "DD.MM.YYYY HH:mm"
+//	"1547918810000" matches UnixMilli
+func ParseAny(value string, options ParseAnyOptions) (DateTime, string, error) {
+	value = strings.TrimSpace(value)
+	location := options.location()
+	for _, format := range options.formats() {
+		if parsedTime, ok := parseDetect(format, value, location); ok {
+			return DateTimeFromTime(parsedTime), format.format, nil
+		}
+	}
+	if parsedTime, format, ok := parseUnix(value, location); ok {
+		return DateTimeFromTime(parsedTime), format, nil
+	}
+	return DateTime{}, "", ValueMatchesNoFormat(value)
+}
+
+// DetectFormat returns the first format of ParseAny, which parses all samples.
+// Empty samples are skipped, so columns with missing values can be detected.
+// The returned format can be used by Parse unless it is a unix pseudo format.
+//
+// For Example:
+//
+//	"01/02/2019" and "13/02/2019" are detected as "DD/MM/YYYY"
+func DetectFormat(samples []string, options ParseAnyOptions) (string, error) {
+	values := make([]string, 0, len(samples))
+	for _, sample := range samples {
+		if sample = strings.TrimSpace(sample); sample != "" {
+			values = append(values, sample)
+		}
+	}
+	if len(values) == 0 {
+		return "", FormatIsNotDetectable(samples)
+	}
+
+	location := options.location()
+	for _, format := range options.formats() {
+		parse := func(value string, location *time.Location) (time.Time, bool) {
+			return parseDetect(format, value, location)
+		}
+		if parsesAll(values, location, parse) {
+			return format.format, nil
+		}
+	}
+	for _, format := range unixFormats {
+		if parsesAll(values, location, format.parse) {
+			return format.format, nil
+		}
+	}
+	return "", FormatIsNotDetectable(samples)
+}
+
+func (o ParseAnyOptions) formats() []compiledFormat {
+	if o.DayFirst {
+		return detectFormatsDayFirst
+	}
+	return detectFormatsMonthFirst
+}
+
+func (o ParseAnyOptions) location() *time.Location {
+	if o.Timezone == "" {
+		return time.UTC
+	}
+	return o.Timezone.Location()
+}
+
+// parseDetect parses value with given compiledFormat of ParseAny
+func parseDetect(format compiledFormat, value string, location *time.Location) (time.Time, bool) {
+	parsedTime, err := format.parse(value, location, EnglishLocale, ParseModeDefault)
+	return parsedTime, err == nil
+}
+
+// parseUnix parses value as unix timestamp, whose unit is decided by its count of digits
+func parseUnix(value string, location *time.Location) (time.Time, string, bool) {
+	for _, format := range unixFormats {
+		if parsedTime, ok := format.parse(value, location); ok {
+			return parsedTime, format.format, true
+		}
+	}
+	return time.Time{}, "", false
+}
+
+func (f unixFormat) parse(value string, location *time.Location) (time.Time, bool) {
+	digits := strings.TrimPrefix(value, "-")
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return time.Time{}, false
+	}
+	if len(digits) < f.minDigits || len(digits) > f.maxDigits {
+		return time.Time{}, false
+	}
+	timestamp, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	seconds, fraction := timestamp/int64(time.Second/f.unit), timestamp%int64(time.Second/f.unit)
+	return time.Unix(seconds, fraction*int64(f.unit)).In(location), true
+}
+
+// parsesAll checks if parse succeeds for all values
+func parsesAll(values []string, location *time.Location, parse func(string, *time.Location) (time.Time, bool)) bool {
+	for _, value := range values {
+		if _, ok := parse(value, location); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// compileDetectFormats compiles the formats of ParseAny in order of priority
+func compileDetectFormats(dayFirst bool) []compiledFormat {
+	formats := []string{
+		"YYYY-MM-DDTHH:mm:ss.Szz",
+		Iso8601TZ,
+		Iso8601,
+		"YYYY-MM-DDTHH:mm:sszz",
+		"YYYY-MM-DDTHH:mm:ssZ",
+		"YYYY-MM-DDTHH:mm:ss",
+		"YYYY-MM-DDTHH:mmzz",
+		"YYYY-MM-DDTHH:mm",
+		"YYYY-MM-DD HH:mm:ss.S",
+		"YYYY-MM-DD HH:mm:sszz",
+		"YYYY-MM-DD HH:mm:ss Z",
+		"YYYY-MM-DD HH:mm:ss",
+		"YYYY-MM-DD HH:mm",
+		"YYYY-MM-DD",
+		"YYYY/MM/DD HH:mm:ss",
+		"YYYY/MM/DD",
+		"YYYYMMDD",
+		Rfc2822,
+		"ddd, D MMM YYYY HH:mm:ss ZZZ",
+		"D MMM YYYY HH:mm:ss Z",
+		CTime,
+		"ddd MMM D HH:mm:ss YYYY",
+		"ddd MMM D HH:mm:ss ZZZ YYYY",
+		"YYYY",
+	}
+
+	dates := []string{
+		"MMMM D, YYYY",
+		"MMM D, YYYY",
+		"dddd, MMMM D, YYYY",
+		"ddd, MMM D, YYYY",
+		"MMMM Do, YYYY",
+		"D MMMM YYYY",
+		"D MMM YYYY",
+		"Do MMMM YYYY",
+	}
+	for _, separator := range []string{"/", "-"} {
+		if dayFirst {
+			dates = append(dates, numericDates(separator, true)...)
+			dates = append(dates, numericDates(separator, false)...)
+		} else {
+			dates = append(dates, numericDates(separator, false)...)
+			dates = append(dates, numericDates(separator, true)...)
+		}
+	}
+	dates = append(dates, numericDates(".", true)...)
+
+	times := []string{"", " HH:mm", " HH:mm:ss", " HH:mm:ss.S", " h:mm A", " h:mm:ss A", " h:mm a", " h:mm:ss a"}
+	for _, date := range dates {
+		for _, timeFormat := range times {
+			formats = append(formats, date+timeFormat)
+		}
+	}
+
+	compiledFormats := make([]compiledFormat, len(formats))
+	for index, format := range formats {
+		compiled, err := compileFormat(format, EnglishLocale)
+		if err != nil {
+			panic(err)
+		}
+		compiledFormats[index] = compiled
+	}
+	return compiledFormats
+}
+
+// numericDates returns the numeric date formats with given separator, padded before unpadded
+func numericDates(separator string, dayFirst bool) []string {
+	first, second := []string{"MM", "M"}, []string{"DD", "D"}
+	if dayFirst {
+		first, second = second, first
+	}
+	var dates []string
+	for _, year := range []string{"YYYY", "YY"} {
+		for index := range first {
+			dates = append(dates, first[index]+separator+second[index]+separator+year)
+		}
+	}
+	return dates
+}
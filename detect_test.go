@@ -0,0 +1,103 @@
+package gostradamus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAny(t *testing.T) {
+	tests := []struct {
+		value    string
+		format   string
+		expected DateTime
+	}{
+		{"2019-01-19T18:26:50.123456Z", "YYYY-MM-DDTHH:mm:ss.Szz", NewDateTime(2019, 1, 19, 18, 26, 50, 123456000, UTC)},
+		{"2019-01-19T18:26:50+01:00", "YYYY-MM-DDTHH:mm:sszz", NewDateTime(2019, 1, 19, 17, 26, 50, 0, UTC)},
+		{"2019-01-19 18:26", "YYYY-MM-DD HH:mm", NewDateTime(2019, 1, 19, 18, 26, 0, 0, UTC)},
+		{"2019-01-19", "YYYY-MM-DD", NewDateTime(2019, 1, 19, 0, 0, 0, 0, UTC)},
+		{"20190119", "YYYYMMDD", NewDateTime(2019, 1, 19, 0, 0, 0, 0, UTC)},
+		{"Sat, 19 Jan 2019 18:26:50 +0100", Rfc2822, NewDateTime(2019, 1, 19, 17, 26, 50, 0, UTC)},
+		{"Sat, 19 Jan 2019 18:26:50 GMT", "ddd, D MMM YYYY HH:mm:ss ZZZ", NewDateTime(2019, 1, 19, 18, 26, 50, 0, UTC)},
+		{"Sat Jan 19 18:26:50 2019", CTime, NewDateTime(2019, 1, 19, 18, 26, 50, 0, UTC)},
+		{"Sat Jan  5 18:26:50 2019", "ddd MMM D HH:mm:ss YYYY", NewDateTime(2019, 1, 5, 18, 26, 50, 0, UTC)},
+		{"January 19, 2019", "MMMM D, YYYY", NewDateTime(2019, 1, 19, 0, 0, 0, 0, UTC)},
+		{"19 Jan 2019 3:07 PM", "D MMM YYYY h:mm A", NewDateTime(2019, 1, 19, 15, 7, 0, 0, UTC)},
+		{"01/02/2019", "MM/DD/YYYY", NewDateTime(2019, 1, 2, 0, 0, 0, 0, UTC)},
+		{"13/02/2019", "DD/MM/YYYY", NewDateTime(2019, 2, 13, 0, 0, 0, 0, UTC)},
+		{"1/2/19 15:07", "M/D/YY HH:mm", NewDateTime(2019, 1, 2, 15, 7, 0, 0, UTC)},
+		{"19.01.2019 18:26", "DD.MM.YYYY HH:mm", NewDateTime(2019, 1, 19, 18, 26, 0, 0, UTC)},
+		{"1547918810", Unix, NewDateTime(2019, 1, 19, 17, 26, 50, 0, UTC)},
+		{"1547918810123", UnixMilli, NewDateTime(2019, 1, 19, 17, 26, 50, 123000000, UTC)},
+		{"1547918810123456", UnixMicro, NewDateTime(2019, 1, 19, 17, 26, 50, 123456000, UTC)},
+		{"1547918810123456789", UnixNano, NewDateTime(2019, 1, 19, 17, 26, 50, 123456789, UTC)},
+		{" 2019-01-19 ", "YYYY-MM-DD", NewDateTime(2019, 1, 19, 0, 0, 0, 0, UTC)},
+		{"2019-01-19T18:26:50.123Z", "YYYY-MM-DDTHH:mm:sszz", NewDateTime(2019, 1, 19, 18, 26, 50, 123000000, UTC)},
+		{"2019-01-19T18:26:50.123456789+01:00", "YYYY-MM-DDTHH:mm:sszz", NewDateTime(2019, 1, 19, 17, 26, 50, 123456789, UTC)},
+		{"2019-01-19 18:26:50.5", "YYYY-MM-DD HH:mm:ss", NewDateTime(2019, 1, 19, 18, 26, 50, 500000000, UTC)},
+		{"19.01.2019 18:26:50.25", "DD.MM.YYYY HH:mm:ss", NewDateTime(2019, 1, 19, 18, 26, 50, 250000000, UTC)},
+		{"2019", "YYYY", NewDateTime(2019, 1, 1, 0, 0, 0, 0, UTC)},
+	}
+	for _, test := range tests {
+		actual, format, err := ParseAny(test.value, ParseAnyOptions{})
+		assert.NoError(t, err, test.value)
+		assert.Equal(t, test.format, format, test.value)
+		assert.Equal(t, test.expected.Time(), actual.Time().UTC(), test.value)
+	}
+}
+
+func TestParseAny_DayFirst(t *testing.T) {
+	actual, format, err := ParseAny("01/02/2019", ParseAnyOptions{DayFirst: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "DD/MM/YYYY", format)
+	assert.Equal(t, NewDateTime(2019, 2, 1, 0, 0, 0, 0, UTC), actual)
+
+	actual, format, err = ParseAny("01/13/2019", ParseAnyOptions{DayFirst: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "MM/DD/YYYY", format)
+	assert.Equal(t, NewDateTime(2019, 1, 13, 0, 0, 0, 0, UTC), actual)
+}
+
+func TestParseAny_Timezone(t *testing.T) {
+	actual, _, err := ParseAny("2019-01-19 18:26", ParseAnyOptions{Timezone: EuropeBerlin})
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2019, 1, 19, 18, 26, 0, 0, EuropeBerlin), actual)
+
+	actual, _, err = ParseAny("1547918810", ParseAnyOptions{Timezone: EuropeBerlin})
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2019, 1, 19, 18, 26, 50, 0, EuropeBerlin), actual)
+}
+
+func TestParseAny_Error(t *testing.T) {
+	for _, value := range []string{"", "someday", "2019-13-01", "32/01/2019", "12345678901234567890", "123", "12345"} {
+		_, _, err := ParseAny(value, ParseAnyOptions{})
+		assert.Equal(t, ValueMatchesNoFormat(value), err, value)
+	}
+}
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		samples  []string
+		options  ParseAnyOptions
+		expected string
+	}{
+		{[]string{"01/02/2019", "", "13/02/2019"}, ParseAnyOptions{}, "DD/MM/YYYY"},
+		{[]string{"01/02/2019", "02/13/2019"}, ParseAnyOptions{DayFirst: true}, "MM/DD/YYYY"},
+		{[]string{"01/02/2019", "03/04/2019"}, ParseAnyOptions{}, "MM/DD/YYYY"},
+		{[]string{"1/2/2019", "12/24/2019"}, ParseAnyOptions{}, "M/D/YYYY"},
+		{[]string{"2019-01-19T18:26:50Z", "2019-01-19T18:26:50+01:00"}, ParseAnyOptions{}, "YYYY-MM-DDTHH:mm:sszz"},
+		{[]string{"1547918810", "999999999"}, ParseAnyOptions{}, Unix},
+	}
+	for _, test := range tests {
+		actual, err := DetectFormat(test.samples, test.options)
+		assert.NoError(t, err, test.samples)
+		assert.Equal(t, test.expected, actual, test.samples)
+	}
+}
+
+func TestDetectFormat_Error(t *testing.T) {
+	for _, samples := range [][]string{nil, {"", " "}, {"2019-01-19", "19.01.2019"}, {"1547918810", "1547918810123"}} {
+		_, err := DetectFormat(samples, ParseAnyOptions{})
+		assert.Equal(t, FormatIsNotDetectable(samples), err, samples)
+	}
+}
@@ -1,6 +1,7 @@
 package gostradamus
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -8,3 +9,124 @@ import (
 func FormatTokenIsNotMapped(formatToken string) error {
 	return fmt.Errorf("FormatToken: %s is not mapped", formatToken)
 }
+
+// UnitIsNotSupported errors the given Unit
+func UnitIsNotSupported(unit Unit) error {
+	return fmt.Errorf("Unit: %d is not supported", unit)
+}
+
+// FormatTokenIsNotParsable errors the given formatToken, which can be formatted but not parsed
+func FormatTokenIsNotParsable(formatToken string) error {
+	return fmt.Errorf("FormatToken: %s is not parsable", formatToken)
+}
+
+// UnitNameIsUnknown errors the given unit name
+func UnitNameIsUnknown(name string) error {
+	return fmt.Errorf("Unit: %s is unknown", name)
+}
+
+// BoundsAreInvalid errors the given Bounds
+func BoundsAreInvalid(bounds Bounds) error {
+	return fmt.Errorf("Bounds: %s are invalid", string(bounds))
+}
+
+// IntervalEndIsBeforeStart errors an Interval, which ends before it starts
+func IntervalEndIsBeforeStart(start DateTime, end DateTime) error {
+	return fmt.Errorf("Interval: end %s is before start %s", end, start)
+}
+
+// IntervalIsNotParsable errors the given interval value
+func IntervalIsNotParsable(value string) error {
+	return fmt.Errorf("Interval: %s is not parsable", value)
+}
+
+// IsoDurationIsNotParsable errors the given ISO-8601 duration value
+func IsoDurationIsNotParsable(value string) error {
+	return fmt.Errorf("IsoDuration: %s is not parsable", value)
+}
+
+// StepIsNotPositive errors the given step
+func StepIsNotPositive(step int) error {
+	return fmt.Errorf("Step: %d is not positive", step)
+}
+
+// RRuleIsNotParsable errors the given recurrence rule value
+func RRuleIsNotParsable(value string) error {
+	return fmt.Errorf("RRule: %s is not parsable", value)
+}
+
+// RRulePartIsNotSupported errors the given recurrence rule part
+func RRulePartIsNotSupported(part string) error {
+	return fmt.Errorf("RRule: %s is not supported", part)
+}
+
+// RecurrenceIsNotParsable errors the given recurrence line
+func RecurrenceIsNotParsable(line string) error {
+	return fmt.Errorf("Recurrence: %s is not parsable", line)
+}
+
+// CronExpressionIsNotParsable errors the given cron expression
+func CronExpressionIsNotParsable(expression string) error {
+	return fmt.Errorf("Cron: %s is not parsable", expression)
+}
+
+// BusinessDayIsNotFound errors a search for a business day, which found none
+func BusinessDayIsNotFound(dateTime DateTime) error {
+	return fmt.Errorf("BusinessDay: no business day found near %s", dateTime)
+}
+
+// WeekPatternIsInvalid errors the given WeekPattern
+func WeekPatternIsInvalid(pattern WeekPattern) error {
+	return fmt.Errorf("WeekPattern: %v is invalid", [3]int(pattern))
+}
+
+// ValueIsNotParsable errors the given value, which could not be parsed with format for given reason
+func ValueIsNotParsable(value string, format string, reason string) error {
+	return fmt.Errorf("Value: %q is not parsable as %q, %s", value, format, reason)
+}
+
+// HumanDateIsNotParsable errors the given human date expression
+func HumanDateIsNotParsable(value string) error {
+	return fmt.Errorf("HumanDate: %s is not parsable", value)
+}
+
+// ValueMatchesNoFormat errors the given value, which matches none of the formats of ParseAny
+func ValueMatchesNoFormat(value string) error {
+	return fmt.Errorf("Value: %q matches no known format", value)
+}
+
+// FormatIsNotDetectable errors the given samples, which have no known format in common
+func FormatIsNotDetectable(samples []string) error {
+	return fmt.Errorf("Format: no known format matches all of %q", samples)
+}
+
+// ValueIsNotParsableAsAny errors the given value, which could not be parsed with any of formats.
+// The errors of the formats are joined, one per line.
+func ValueIsNotParsableAsAny(value string, formats []string, formatErrors []error) error {
+	return errors.Join(append([]error{fmt.Errorf("Value: %q is not parsable as any of %q", value, formats)}, formatErrors...)...)
+}
+
+// DirectiveIsNotSupported errors the given strftime directive
+func DirectiveIsNotSupported(directive string) error {
+	return fmt.Errorf("Directive: %s is not supported", directive)
+}
+
+// DirectiveIsNotConvertible errors the given strftime directive, which has no equivalent FormatToken
+func DirectiveIsNotConvertible(directive string) error {
+	return fmt.Errorf("Directive: %s is not convertible", directive)
+}
+
+// FormatTokenIsNotConvertible errors the given formatToken, which has no equivalent strftime directive or Go layout
+func FormatTokenIsNotConvertible(formatToken string) error {
+	return fmt.Errorf("FormatToken: %s is not convertible", formatToken)
+}
+
+// FormatIsNotConvertible errors the given format, which can not be converted without changing its meaning
+func FormatIsNotConvertible(format string) error {
+	return fmt.Errorf("Format: %s is not convertible", format)
+}
+
+// GoLayoutElementIsNotConvertible errors the given element of a Go layout, which has no equivalent FormatToken
+func GoLayoutElementIsNotConvertible(element string) error {
+	return fmt.Errorf("GoLayout: %s is not convertible", element)
+}
@@ -14,3 +14,225 @@ func TestFormatTokenIsNotMapped(t *testing.T) {
 		actual,
 	)
 }
+
+func TestUnitIsNotSupported(t *testing.T) {
+	actual := UnitIsNotSupported(Unit(42))
+	assert.Equal(
+		t,
+		errors.New("Unit: 42 is not supported"),
+		actual,
+	)
+}
+
+func TestFormatTokenIsNotParsable(t *testing.T) {
+	actual := FormatTokenIsNotParsable("ww")
+	assert.Equal(
+		t,
+		errors.New("FormatToken: ww is not parsable"),
+		actual,
+	)
+}
+
+func TestUnitNameIsUnknown(t *testing.T) {
+	actual := UnitNameIsUnknown("fortnight")
+	assert.Equal(
+		t,
+		errors.New("Unit: fortnight is unknown"),
+		actual,
+	)
+}
+
+func TestBoundsAreInvalid(t *testing.T) {
+	actual := BoundsAreInvalid("[[")
+	assert.Equal(
+		t,
+		errors.New("Bounds: [[ are invalid"),
+		actual,
+	)
+}
+
+func TestIntervalEndIsBeforeStart(t *testing.T) {
+	actual := IntervalEndIsBeforeStart(
+		NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0),
+		NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0),
+	)
+	assert.Equal(
+		t,
+		errors.New("Interval: end 2020-01-01T00:00:00.000000Z is before start 2020-01-02T00:00:00.000000Z"),
+		actual,
+	)
+}
+
+func TestIntervalIsNotParsable(t *testing.T) {
+	actual := IntervalIsNotParsable("2020")
+	assert.Equal(
+		t,
+		errors.New("Interval: 2020 is not parsable"),
+		actual,
+	)
+}
+
+func TestIsoDurationIsNotParsable(t *testing.T) {
+	actual := IsoDurationIsNotParsable("P1X")
+	assert.Equal(
+		t,
+		errors.New("IsoDuration: P1X is not parsable"),
+		actual,
+	)
+}
+
+func TestStepIsNotPositive(t *testing.T) {
+	actual := StepIsNotPositive(0)
+	assert.Equal(
+		t,
+		errors.New("Step: 0 is not positive"),
+		actual,
+	)
+}
+
+func TestRRuleIsNotParsable(t *testing.T) {
+	actual := RRuleIsNotParsable("FREQ=FORTNIGHTLY")
+	assert.Equal(
+		t,
+		errors.New("RRule: FREQ=FORTNIGHTLY is not parsable"),
+		actual,
+	)
+}
+
+func TestRRulePartIsNotSupported(t *testing.T) {
+	actual := RRulePartIsNotSupported("BYWEEKNO")
+	assert.Equal(
+		t,
+		errors.New("RRule: BYWEEKNO is not supported"),
+		actual,
+	)
+}
+
+func TestRecurrenceIsNotParsable(t *testing.T) {
+	actual := RecurrenceIsNotParsable("DTEND:20121212T000000Z")
+	assert.Equal(
+		t,
+		errors.New("Recurrence: DTEND:20121212T000000Z is not parsable"),
+		actual,
+	)
+}
+
+func TestCronExpressionIsNotParsable(t *testing.T) {
+	actual := CronExpressionIsNotParsable("* * *")
+	assert.Equal(
+		t,
+		errors.New("Cron: * * * is not parsable"),
+		actual,
+	)
+}
+
+func TestBusinessDayIsNotFound(t *testing.T) {
+	actual := BusinessDayIsNotFound(NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0))
+	assert.Equal(
+		t,
+		errors.New("BusinessDay: no business day found near 2020-01-01T00:00:00.000000Z"),
+		actual,
+	)
+}
+
+func TestWeekPatternIsInvalid(t *testing.T) {
+	actual := WeekPatternIsInvalid(WeekPattern{4, 4, 4})
+	assert.Equal(
+		t,
+		errors.New("WeekPattern: [4 4 4] is invalid"),
+		actual,
+	)
+}
+
+func TestValueIsNotParsable(t *testing.T) {
+	actual := ValueIsNotParsable("2012-13", "YYYY-MM", "month 13 is out of range")
+	assert.Equal(
+		t,
+		errors.New(`Value: "2012-13" is not parsable as "YYYY-MM", month 13 is out of range`),
+		actual,
+	)
+}
+
+func TestHumanDateIsNotParsable(t *testing.T) {
+	actual := HumanDateIsNotParsable("someday")
+	assert.Equal(
+		t,
+		errors.New("HumanDate: someday is not parsable"),
+		actual,
+	)
+}
+
+func TestValueMatchesNoFormat(t *testing.T) {
+	actual := ValueMatchesNoFormat("someday")
+	assert.Equal(
+		t,
+		errors.New(`Value: "someday" matches no known format`),
+		actual,
+	)
+}
+
+func TestFormatIsNotDetectable(t *testing.T) {
+	actual := FormatIsNotDetectable([]string{"2019-01-19", "19.01.2019"})
+	assert.Equal(
+		t,
+		errors.New(`Format: no known format matches all of ["2019-01-19" "19.01.2019"]`),
+		actual,
+	)
+}
+
+func TestValueIsNotParsableAsAny(t *testing.T) {
+	formatError := ValueIsNotParsable("2012-13", "YYYY-MM", "month 13 is out of range")
+	actual := ValueIsNotParsableAsAny("2012-13", []string{"YYYY-MM"}, []error{formatError})
+	assert.EqualError(
+		t,
+		actual,
+		"Value: \"2012-13\" is not parsable as any of [\"YYYY-MM\"]\n"+
+			"Value: \"2012-13\" is not parsable as \"YYYY-MM\", month 13 is out of range",
+	)
+	assert.ErrorIs(t, actual, formatError)
+}
+
+func TestDirectiveIsNotSupported(t *testing.T) {
+	actual := DirectiveIsNotSupported("%Q")
+	assert.Equal(
+		t,
+		errors.New("Directive: %Q is not supported"),
+		actual,
+	)
+}
+
+func TestDirectiveIsNotConvertible(t *testing.T) {
+	actual := DirectiveIsNotConvertible("%U")
+	assert.Equal(
+		t,
+		errors.New("Directive: %U is not convertible"),
+		actual,
+	)
+}
+
+func TestFormatTokenIsNotConvertible(t *testing.T) {
+	actual := FormatTokenIsNotConvertible("Do")
+	assert.Equal(
+		t,
+		errors.New("FormatToken: Do is not convertible"),
+		actual,
+	)
+}
+
+func TestFormatIsNotConvertible(t *testing.T) {
+	actual := FormatIsNotConvertible("[Mon] HH:mm")
+	assert.Equal(
+		t,
+		errors.New("Format: [Mon] HH:mm is not convertible"),
+		actual,
+	)
+}
+
+func TestGoLayoutElementIsNotConvertible(t *testing.T) {
+	actual := GoLayoutElementIsNotConvertible("_2")
+	assert.Equal(
+		t,
+		errors.New("GoLayout: _2 is not convertible"),
+		actual,
+	)
+}
@@ -0,0 +1,268 @@
+package gostradamus
+
+import "time"
+
+// WeekPattern are the weeks of the three periods of every quarter of a 52/53 week fiscal year
+type WeekPattern [3]int
+
+// FiscalStartRule decides on which day of the start month a 52/53 week fiscal year starts
+type FiscalStartRule int
+
+// All FiscalStartRules
+const (
+	// FiscalStartFirstWeekday starts the fiscal year on the first StartWeekday of the start month
+	FiscalStartFirstWeekday FiscalStartRule = iota
+	// FiscalStartLastWeekday starts the fiscal year on the last StartWeekday of the start month
+	FiscalStartLastWeekday
+	// FiscalStartNearestWeekday starts the fiscal year on the StartWeekday nearest to the first day of the start month
+	FiscalStartNearestWeekday
+)
+
+var (
+	// Pattern445 has 4, 4 and 5 weeks per quarter
+	Pattern445 = WeekPattern{4, 4, 5}
+	// Pattern454 has 4, 5 and 4 weeks per quarter
+	Pattern454 = WeekPattern{4, 5, 4}
+	// Pattern544 has 5, 4 and 4 weeks per quarter
+	Pattern544 = WeekPattern{5, 4, 4}
+)
+
+// FiscalCalendar defines fiscal years, which start on the first day of a month
+// or, with a WeekPattern, are 52/53 week years starting on a weekday.
+// Fiscal years have 4 quarters of 3 periods each.
+type FiscalCalendar struct {
+	// StartMonth is the month the fiscal year starts in
+	StartMonth time.Month
+	// NamedByStartYear names a fiscal year by the calendar year it starts in instead of the one it ends in
+	NamedByStartYear bool
+	// WeekPattern makes the FiscalCalendar a 52/53 week calendar, the zero WeekPattern uses calendar months as periods.
+	// In years with 53 weeks the last period contains the additional week.
+	WeekPattern WeekPattern
+	// StartWeekday is the weekday 52/53 week fiscal years start on
+	StartWeekday time.Weekday
+	// StartRule decides on which StartWeekday of StartMonth 52/53 week fiscal years start
+	StartRule FiscalStartRule
+}
+
+// NewFiscalCalendar returns a new FiscalCalendar with fiscal years starting on the first day of startMonth,
+// which are named by the calendar year they end in
+//
+// For Example:
+//
+//	NewFiscalCalendar(time.April) has the fiscal year 2013 from 2012-04-01 to 2013-03-31
+func NewFiscalCalendar(startMonth time.Month) FiscalCalendar {
+	return FiscalCalendar{StartMonth: startMonth}
+}
+
+// NewRetailFiscalCalendar returns a new 52/53 week FiscalCalendar,
+// which starts on the startWeekday of startMonth chosen by startRule and
+// whose quarters are split into periods by pattern
+//
+// For Example:
+//
+//	NewRetailFiscalCalendar(time.October, time.Saturday, FiscalStartFirstWeekday, Pattern445)
+//	starts the fiscal year 2013 on saturday 2012-10-06
+//
+// NewRetailFiscalCalendar panics if pattern does not have 13 weeks
+func NewRetailFiscalCalendar(
+	startMonth time.Month,
+	startWeekday time.Weekday,
+	startRule FiscalStartRule,
+	pattern WeekPattern,
+) FiscalCalendar {
+	if !pattern.isValid() {
+		panic(WeekPatternIsInvalid(pattern))
+	}
+	return FiscalCalendar{
+		StartMonth:   startMonth,
+		WeekPattern:  pattern,
+		StartWeekday: startWeekday,
+		StartRule:    startRule,
+	}
+}
+
+// FiscalYear returns the fiscal year of given DateTime
+func (fc FiscalCalendar) FiscalYear(dateTime DateTime) int {
+	return fc.yearName(fc.startYearOf(civilMidnight(dateTime)))
+}
+
+// FiscalQuarter returns the fiscal quarter (1-4) of given DateTime
+func (fc FiscalCalendar) FiscalQuarter(dateTime DateTime) int {
+	return (fc.FiscalPeriod(dateTime)-1)/3 + 1
+}
+
+// FiscalPeriod returns the fiscal period (1-12) of given DateTime.
+// Periods are calendar months or, in 52/53 week calendars, the weeks of the WeekPattern.
+func (fc FiscalCalendar) FiscalPeriod(dateTime DateTime) int {
+	date := civilMidnight(dateTime)
+	startYear := fc.startYearOf(date)
+	period := 1
+	for period < 12 && !date.Before(fc.periodStart(startYear, period+1)) {
+		period++
+	}
+	return period
+}
+
+// FiscalWeek returns the week (1-53) of the fiscal year of given DateTime.
+// The first week starts on the first day of the fiscal year.
+func (fc FiscalCalendar) FiscalWeek(dateTime DateTime) int {
+	date := civilMidnight(dateTime)
+	return daysBetween(fc.yearStart(fc.startYearOf(date)), date)/WeekInDays + 1
+}
+
+// WeeksInFiscalYear returns the count of started weeks of given fiscal year,
+// which is 52 or 53 for 52/53 week calendars
+func (fc FiscalCalendar) WeeksInFiscalYear(fiscalYear int) int {
+	startYear := fc.startYearOfName(fiscalYear)
+	return (daysBetween(fc.yearStart(startYear), fc.yearStart(startYear+1)) + WeekInDays - 1) / WeekInDays
+}
+
+// FloorFiscalYear returns the first nanosecond of the fiscal year of given DateTime
+func (fc FiscalCalendar) FloorFiscalYear(dateTime DateTime) DateTime {
+	start, _ := fc.SpanFiscalYear(dateTime)
+	return start
+}
+
+// CeilFiscalYear returns the last nanosecond of the fiscal year of given DateTime
+func (fc FiscalCalendar) CeilFiscalYear(dateTime DateTime) DateTime {
+	_, end := fc.SpanFiscalYear(dateTime)
+	return end
+}
+
+// SpanFiscalYear returns the first and the last nanosecond of the fiscal year of given DateTime
+func (fc FiscalCalendar) SpanFiscalYear(dateTime DateTime) (DateTime, DateTime) {
+	startYear := fc.startYearOf(civilMidnight(dateTime))
+	return fc.span(dateTime, fc.yearStart(startYear), fc.yearStart(startYear+1))
+}
+
+// FloorFiscalQuarter returns the first nanosecond of the fiscal quarter of given DateTime
+func (fc FiscalCalendar) FloorFiscalQuarter(dateTime DateTime) DateTime {
+	start, _ := fc.SpanFiscalQuarter(dateTime)
+	return start
+}
+
+// CeilFiscalQuarter returns the last nanosecond of the fiscal quarter of given DateTime
+func (fc FiscalCalendar) CeilFiscalQuarter(dateTime DateTime) DateTime {
+	_, end := fc.SpanFiscalQuarter(dateTime)
+	return end
+}
+
+// SpanFiscalQuarter returns the first and the last nanosecond of the fiscal quarter of given DateTime
+func (fc FiscalCalendar) SpanFiscalQuarter(dateTime DateTime) (DateTime, DateTime) {
+	startYear := fc.startYearOf(civilMidnight(dateTime))
+	firstPeriod := (fc.FiscalQuarter(dateTime)-1)*3 + 1
+	return fc.span(dateTime, fc.periodStart(startYear, firstPeriod), fc.periodStart(startYear, firstPeriod+3))
+}
+
+// FloorFiscalPeriod returns the first nanosecond of the fiscal period of given DateTime
+func (fc FiscalCalendar) FloorFiscalPeriod(dateTime DateTime) DateTime {
+	start, _ := fc.SpanFiscalPeriod(dateTime)
+	return start
+}
+
+// CeilFiscalPeriod returns the last nanosecond of the fiscal period of given DateTime
+func (fc FiscalCalendar) CeilFiscalPeriod(dateTime DateTime) DateTime {
+	_, end := fc.SpanFiscalPeriod(dateTime)
+	return end
+}
+
+// SpanFiscalPeriod returns the first and the last nanosecond of the fiscal period of given DateTime
+func (fc FiscalCalendar) SpanFiscalPeriod(dateTime DateTime) (DateTime, DateTime) {
+	startYear := fc.startYearOf(civilMidnight(dateTime))
+	period := fc.FiscalPeriod(dateTime)
+	return fc.span(dateTime, fc.periodStart(startYear, period), fc.periodStart(startYear, period+1))
+}
+
+// isRetail checks if the FiscalCalendar is a 52/53 week calendar
+func (fc FiscalCalendar) isRetail() bool {
+	return fc.WeekPattern != WeekPattern{}
+}
+
+// yearStart returns the first day of the fiscal year starting in given calendar year as UTC midnight
+func (fc FiscalCalendar) yearStart(startYear int) time.Time {
+	firstOfMonth := time.Date(startYear, fc.StartMonth, 1, 0, 0, 0, 0, time.UTC)
+	if !fc.isRetail() {
+		return firstOfMonth
+	}
+	if !fc.WeekPattern.isValid() {
+		panic(WeekPatternIsInvalid(fc.WeekPattern))
+	}
+
+	first := firstOfMonth.AddDate(0, 0, daysSinceWeekday(fc.StartWeekday, firstOfMonth.Weekday()))
+	switch fc.StartRule {
+	case FiscalStartLastWeekday:
+		last := firstOfMonth.AddDate(0, 1, -1)
+		return last.AddDate(0, 0, -daysSinceWeekday(last.Weekday(), fc.StartWeekday))
+	case FiscalStartNearestWeekday:
+		if daysBetween(firstOfMonth, first) > 3 {
+			return first.AddDate(0, 0, -WeekInDays)
+		}
+	}
+	return first
+}
+
+// startYearOf returns the calendar year the fiscal year of given UTC midnight starts in
+func (fc FiscalCalendar) startYearOf(date time.Time) int {
+	startYear := date.Year() + 1
+	for date.Before(fc.yearStart(startYear)) {
+		startYear--
+	}
+	return startYear
+}
+
+// yearName returns the name of the fiscal year starting in given calendar year
+func (fc FiscalCalendar) yearName(startYear int) int {
+	if fc.NamedByStartYear {
+		return startYear
+	}
+	return fc.yearStart(startYear+1).AddDate(0, 0, -1).Year()
+}
+
+// startYearOfName returns the calendar year the fiscal year with given name starts in
+func (fc FiscalCalendar) startYearOfName(fiscalYear int) int {
+	if fc.NamedByStartYear || fc.yearName(fiscalYear) == fiscalYear {
+		return fiscalYear
+	}
+	return fiscalYear - 1
+}
+
+// periodStart returns the first day of given period (1-13) of the fiscal year starting in given calendar year.
+// Period 13 is the start of the next fiscal year.
+func (fc FiscalCalendar) periodStart(startYear int, period int) time.Time {
+	if period > 12 {
+		return fc.yearStart(startYear + 1)
+	}
+	if !fc.isRetail() {
+		return fc.yearStart(startYear).AddDate(0, period-1, 0)
+	}
+	weeks := 0
+	for p := 1; p < period; p++ {
+		weeks += fc.WeekPattern[(p-1)%3]
+	}
+	return fc.yearStart(startYear).AddDate(0, 0, weeks*WeekInDays)
+}
+
+// span returns the first and last nanosecond between two UTC midnights as DateTimes in the timezone of given DateTime
+func (fc FiscalCalendar) span(dateTime DateTime, start time.Time, end time.Time) (DateTime, DateTime) {
+	location := dateTime.Time().Location()
+	atMidnight := func(date time.Time) time.Time {
+		return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, location)
+	}
+	return DateTimeFromTime(atMidnight(start)), DateTimeFromTime(atMidnight(end).Add(-1))
+}
+
+// isValid checks if the WeekPattern has 13 weeks and no empty period
+func (p WeekPattern) isValid() bool {
+	return p[0] > 0 && p[1] > 0 && p[2] > 0 && p[0]+p[1]+p[2] == 13
+}
+
+// civilMidnight returns the day of given DateTime, in its own timezone, as UTC midnight
+func civilMidnight(dateTime DateTime) time.Time {
+	t := dateTime.Time()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// daysBetween returns the days from start to end, both UTC midnights
+func daysBetween(start time.Time, end time.Time) int {
+	return int(end.Sub(start) / (24 * time.Hour))
+}
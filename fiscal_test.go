@@ -0,0 +1,116 @@
+package gostradamus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFiscalCalendar_Monthly(t *testing.T) {
+	calendar := NewFiscalCalendar(time.April)
+
+	assert.Equal(t, 2012, calendar.FiscalYear(NewUTCDateTime(2012, 3, 31, 23, 59, 59, 0)))
+	assert.Equal(t, 2013, calendar.FiscalYear(NewUTCDateTime(2012, 4, 1, 0, 0, 0, 0)))
+	assert.Equal(t, 2, calendar.FiscalQuarter(NewUTCDateTime(2012, 7, 15, 0, 0, 0, 0)))
+	assert.Equal(t, 4, calendar.FiscalPeriod(NewUTCDateTime(2012, 7, 15, 0, 0, 0, 0)))
+	assert.Equal(t, 12, calendar.FiscalPeriod(NewUTCDateTime(2013, 3, 31, 0, 0, 0, 0)))
+	assert.Equal(t, 4, calendar.FiscalQuarter(NewUTCDateTime(2013, 3, 31, 0, 0, 0, 0)))
+	assert.Equal(t, 1, calendar.FiscalWeek(NewUTCDateTime(2012, 4, 7, 0, 0, 0, 0)))
+	assert.Equal(t, 2, calendar.FiscalWeek(NewUTCDateTime(2012, 4, 8, 0, 0, 0, 0)))
+	assert.Equal(t, 53, calendar.WeeksInFiscalYear(2013))
+
+	calendar.NamedByStartYear = true
+	assert.Equal(t, 2012, calendar.FiscalYear(NewUTCDateTime(2012, 4, 1, 0, 0, 0, 0)))
+	assert.Equal(t, 2011, calendar.FiscalYear(NewUTCDateTime(2012, 3, 31, 0, 0, 0, 0)))
+
+	// A fiscal year starting in january is the calendar year
+	calendar = NewFiscalCalendar(time.January)
+	assert.Equal(t, 2012, calendar.FiscalYear(NewUTCDateTime(2012, 12, 31, 0, 0, 0, 0)))
+	assert.Equal(t, 4, calendar.FiscalQuarter(NewUTCDateTime(2012, 12, 31, 0, 0, 0, 0)))
+}
+
+func TestFiscalCalendar_Monthly_Spans(t *testing.T) {
+	calendar := NewFiscalCalendar(time.April)
+	dateTime := NewDateTime(2012, 7, 15, 12, 0, 0, 0, EuropeBerlin)
+
+	start, end := calendar.SpanFiscalYear(dateTime)
+	assert.Equal(t, NewDateTime(2012, 4, 1, 0, 0, 0, 0, EuropeBerlin), start)
+	assert.Equal(t, NewDateTime(2013, 3, 31, 23, 59, 59, 999999999, EuropeBerlin), end)
+
+	start, end = calendar.SpanFiscalQuarter(dateTime)
+	assert.Equal(t, NewDateTime(2012, 7, 1, 0, 0, 0, 0, EuropeBerlin), start)
+	assert.Equal(t, NewDateTime(2012, 9, 30, 23, 59, 59, 999999999, EuropeBerlin), end)
+
+	start, end = calendar.SpanFiscalPeriod(dateTime)
+	assert.Equal(t, NewDateTime(2012, 7, 1, 0, 0, 0, 0, EuropeBerlin), start)
+	assert.Equal(t, NewDateTime(2012, 7, 31, 23, 59, 59, 999999999, EuropeBerlin), end)
+
+	assert.Equal(t, NewDateTime(2012, 4, 1, 0, 0, 0, 0, EuropeBerlin), calendar.FloorFiscalYear(dateTime))
+	assert.Equal(t, NewDateTime(2013, 3, 31, 23, 59, 59, 999999999, EuropeBerlin), calendar.CeilFiscalYear(dateTime))
+	assert.Equal(t, NewDateTime(2012, 7, 1, 0, 0, 0, 0, EuropeBerlin), calendar.FloorFiscalQuarter(dateTime))
+	assert.Equal(t, NewDateTime(2012, 9, 30, 23, 59, 59, 999999999, EuropeBerlin), calendar.CeilFiscalQuarter(dateTime))
+	assert.Equal(t, NewDateTime(2012, 7, 1, 0, 0, 0, 0, EuropeBerlin), calendar.FloorFiscalPeriod(dateTime))
+	assert.Equal(t, NewDateTime(2012, 7, 31, 23, 59, 59, 999999999, EuropeBerlin), calendar.CeilFiscalPeriod(dateTime))
+}
+
+func TestFiscalCalendar_Retail(t *testing.T) {
+	calendar := NewRetailFiscalCalendar(time.October, time.Saturday, FiscalStartFirstWeekday, Pattern445)
+
+	assert.Equal(t, 2012, calendar.FiscalYear(NewUTCDateTime(2012, 10, 5, 0, 0, 0, 0)))
+	assert.Equal(t, 2013, calendar.FiscalYear(NewUTCDateTime(2012, 10, 6, 0, 0, 0, 0)))
+	assert.Equal(t, 52, calendar.WeeksInFiscalYear(2013))
+	assert.Equal(t, 53, calendar.WeeksInFiscalYear(2017))
+
+	assert.Equal(t, 2, calendar.FiscalPeriod(NewUTCDateTime(2012, 11, 3, 0, 0, 0, 0)))
+	assert.Equal(t, 3, calendar.FiscalPeriod(NewUTCDateTime(2012, 12, 1, 0, 0, 0, 0)))
+	assert.Equal(t, 1, calendar.FiscalQuarter(NewUTCDateTime(2013, 1, 4, 0, 0, 0, 0)))
+	assert.Equal(t, 2, calendar.FiscalQuarter(NewUTCDateTime(2013, 1, 5, 0, 0, 0, 0)))
+
+	start, end := calendar.SpanFiscalQuarter(NewUTCDateTime(2013, 2, 1, 0, 0, 0, 0))
+	assert.Equal(t, NewUTCDateTime(2013, 1, 5, 0, 0, 0, 0), start)
+	assert.Equal(t, NewUTCDateTime(2013, 4, 5, 23, 59, 59, 999999999), end)
+
+	// The additional week of a 53 week year belongs to the last period
+	start, end = calendar.SpanFiscalPeriod(NewUTCDateTime(2017, 10, 6, 0, 0, 0, 0))
+	assert.Equal(t, NewUTCDateTime(2017, 8, 26, 0, 0, 0, 0), start)
+	assert.Equal(t, NewUTCDateTime(2017, 10, 6, 23, 59, 59, 999999999), end)
+	assert.Equal(t, 53, calendar.FiscalWeek(NewUTCDateTime(2017, 10, 6, 0, 0, 0, 0)))
+	assert.Equal(t, 12, calendar.FiscalPeriod(NewUTCDateTime(2017, 10, 6, 0, 0, 0, 0)))
+
+	start, end = calendar.SpanFiscalYear(NewUTCDateTime(2017, 1, 1, 0, 0, 0, 0))
+	assert.Equal(t, NewUTCDateTime(2016, 10, 1, 0, 0, 0, 0), start)
+	assert.Equal(t, NewUTCDateTime(2017, 10, 6, 23, 59, 59, 999999999), end)
+}
+
+func TestFiscalCalendar_RetailStartRules(t *testing.T) {
+	// 2015-02-01 is a sunday
+	calendar := NewRetailFiscalCalendar(time.February, time.Saturday, FiscalStartNearestWeekday, Pattern454)
+	assert.Equal(t, NewUTCDateTime(2015, 1, 31, 0, 0, 0, 0), calendar.FloorFiscalYear(NewUTCDateTime(2015, 6, 1, 0, 0, 0, 0)))
+	assert.Equal(t, 2016, calendar.FiscalYear(NewUTCDateTime(2015, 1, 31, 0, 0, 0, 0)))
+	assert.Equal(t, 2015, calendar.FiscalYear(NewUTCDateTime(2015, 1, 30, 0, 0, 0, 0)))
+
+	start, end := calendar.SpanFiscalPeriod(NewUTCDateTime(2015, 3, 1, 0, 0, 0, 0))
+	assert.Equal(t, NewUTCDateTime(2015, 2, 28, 0, 0, 0, 0), start)
+	assert.Equal(t, NewUTCDateTime(2015, 4, 3, 23, 59, 59, 999999999), end)
+
+	calendar = NewRetailFiscalCalendar(time.January, time.Saturday, FiscalStartLastWeekday, Pattern544)
+	assert.Equal(t, NewUTCDateTime(2015, 1, 31, 0, 0, 0, 0), calendar.FloorFiscalYear(NewUTCDateTime(2015, 6, 1, 0, 0, 0, 0)))
+	assert.Equal(t, NewUTCDateTime(2014, 1, 25, 0, 0, 0, 0), calendar.FloorFiscalYear(NewUTCDateTime(2015, 1, 30, 0, 0, 0, 0)))
+	assert.Equal(t, 2016, calendar.FiscalYear(NewUTCDateTime(2015, 6, 1, 0, 0, 0, 0)))
+
+	assert.PanicsWithError(
+		t,
+		"WeekPattern: [4 4 4] is invalid",
+		func() {
+			NewRetailFiscalCalendar(time.January, time.Saturday, FiscalStartLastWeekday, WeekPattern{4, 4, 4})
+		},
+	)
+	assert.PanicsWithError(
+		t,
+		"WeekPattern: [5 5 4] is invalid",
+		func() {
+			FiscalCalendar{WeekPattern: WeekPattern{5, 5, 4}}.FiscalYear(NewUTCDateTime(2015, 6, 1, 0, 0, 0, 0))
+		},
+	)
+}
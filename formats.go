@@ -19,4 +19,34 @@ const (
 	//     Example: Sat Jan 19 18:26:50 2019
 	//
 	CTime = "ddd MMM DD HH:mm:ss YYYY"
+
+	// Rfc2822 format of internet messages
+	//
+	//     Example: Sat, 19 Jan 2019 18:26:50 +0100
+	//
+	Rfc2822 = "ddd, D MMM YYYY HH:mm:ss Z"
+
+	// Unix timestamp in seconds, only used by ParseAny and DetectFormat
+	//
+	//     Example: 1547918810
+	//
+	Unix = "unix"
+
+	// UnixMilli timestamp in milliseconds, only used by ParseAny and DetectFormat
+	//
+	//     Example: 1547918810000
+	//
+	UnixMilli = "unix-ms"
+
+	// UnixMicro timestamp in microseconds, only used by ParseAny and DetectFormat
+	//
+	//     Example: 1547918810000000
+	//
+	UnixMicro = "unix-us"
+
+	// UnixNano timestamp in nanoseconds, only used by ParseAny and DetectFormat
+	//
+	//     Example: 1547918810000000000
+	//
+	UnixNano = "unix-ns"
 )
@@ -1,11 +1,10 @@
 package gostradamus
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 	"time"
-
-	"github.com/dustin/go-humanize"
 )
 
 // All FormatTokens for parsing and formatting with DateTime
@@ -26,7 +25,15 @@ const (
 	DayOfWeekFullName = FormatToken("dddd")
 	DayOfWeekAbbr     = FormatToken("ddd")
 
+	WeekYear                = FormatToken("gggg")
+	WeekOfYearZeroPadded    = FormatToken("ww")
+	WeekOfYear              = FormatToken("w")
+	IsoWeekYear             = FormatToken("GGGG")
+	IsoWeekOfYearZeroPadded = FormatToken("WW")
+	IsoWeekOfYear           = FormatToken("W")
+
 	TwentyFourHourZeroPadded = FormatToken("HH")
+	TwentyFourHour           = FormatToken("H")
 	TwelveHourZeroPadded     = FormatToken("hh")
 	TwelveHour               = FormatToken("h")
 
@@ -44,6 +51,13 @@ const (
 	TimezoneWithColon    = FormatToken("zz")
 	TimezoneWithoutColon = FormatToken("Z")
 
+	LocalizedDateTimeFull    = FormatToken("LLLL")
+	LocalizedDateTime        = FormatToken("LLL")
+	LocalizedDateLong        = FormatToken("LL")
+	LocalizedDate            = FormatToken("L")
+	LocalizedTimeWithSeconds = FormatToken("LTS")
+	LocalizedTime            = FormatToken("LT")
+
 	GoLongMonth             = goFormatToken("January")
 	GoMonth                 = goFormatToken("Jan")
 	GoNumMonth              = goFormatToken("1")
@@ -128,7 +142,14 @@ var (
 		DayOfMonthShort,
 		DayOfWeekFullName,
 		DayOfWeekAbbr,
+		WeekYear,
+		WeekOfYearZeroPadded,
+		WeekOfYear,
+		IsoWeekYear,
+		IsoWeekOfYearZeroPadded,
+		IsoWeekOfYear,
 		TwentyFourHourZeroPadded,
+		TwentyFourHour,
 		TwelveHourZeroPadded,
 		TwelveHour,
 		AMPMUpper,
@@ -141,6 +162,70 @@ var (
 		TimezoneFullName,
 		TimezoneWithColon,
 		TimezoneWithoutColon,
+		LocalizedDateTimeFull,
+		LocalizedDateTime,
+		LocalizedTimeWithSeconds,
+		LocalizedTime,
+		LocalizedDateLong,
+		LocalizedDate,
+	}
+
+	// layoutRegexp matches FormatTokens and escaped literals in square brackets
+	layoutRegexp = regexp.MustCompile(`\[[^\]]*\]|` + formatTokenRegex())
+
+	// formatTokenFormatters format FormatTokens, which have no equivalent goFormatToken or depend on the Locale
+	formatTokenFormatters = map[FormatToken]func(value time.Time, locale Locale) string{
+		MonthFull: func(value time.Time, locale Locale) string {
+			return locale.month(value.Month())
+		},
+		MonthAbbr: func(value time.Time, locale Locale) string {
+			return locale.monthShort(value.Month())
+		},
+		DayOfWeekFullName: func(value time.Time, locale Locale) string {
+			return locale.Weekdays[value.Weekday()]
+		},
+		DayOfWeekAbbr: func(value time.Time, locale Locale) string {
+			return locale.WeekdaysShort[value.Weekday()]
+		},
+		AMPMUpper: func(value time.Time, locale Locale) string {
+			return locale.meridiem(value.Hour())
+		},
+		AMPMLower: func(value time.Time, locale Locale) string {
+			return strings.ToLower(locale.meridiem(value.Hour()))
+		},
+		DayOfMonthOrdinal: func(value time.Time, locale Locale) string {
+			return locale.ordinal(value.Day())
+		},
+		TwentyFourHour: func(value time.Time, _ Locale) string {
+			return fmt.Sprint(value.Hour())
+		},
+		MicroSecond: func(value time.Time, _ Locale) string {
+			return fmt.Sprintf("%06d", value.Nanosecond()/int(time.Microsecond))
+		},
+		WeekYear: func(value time.Time, locale Locale) string {
+			year, _ := locale.WeekCalendar.Week(DateTimeFromTime(value))
+			return fmt.Sprintf("%04d", year)
+		},
+		WeekOfYearZeroPadded: func(value time.Time, locale Locale) string {
+			_, week := locale.WeekCalendar.Week(DateTimeFromTime(value))
+			return fmt.Sprintf("%02d", week)
+		},
+		WeekOfYear: func(value time.Time, locale Locale) string {
+			_, week := locale.WeekCalendar.Week(DateTimeFromTime(value))
+			return fmt.Sprint(week)
+		},
+		IsoWeekYear: func(value time.Time, _ Locale) string {
+			year, _ := value.ISOWeek()
+			return fmt.Sprintf("%04d", year)
+		},
+		IsoWeekOfYearZeroPadded: func(value time.Time, _ Locale) string {
+			_, week := value.ISOWeek()
+			return fmt.Sprintf("%02d", week)
+		},
+		IsoWeekOfYear: func(value time.Time, _ Locale) string {
+			_, week := value.ISOWeek()
+			return fmt.Sprint(week)
+		},
 	}
 )
 
@@ -156,65 +241,44 @@ func formatTokenRegex() string {
 	return strings.Join(allFormatTokens.toStringSlice(), "|")
 }
 
-// translateFormat translates all mapped formats to Go specific language
-func translateFormat(format string) string {
-	re := regexp.MustCompile(formatTokenRegex())
-	format = string(re.ReplaceAllFunc(
-		[]byte(format),
-		func(bytes []byte) []byte {
-			if goFormatToken, ok := formatTokenMap[FormatToken(bytes)]; ok {
-				return []byte(goFormatToken)
-			}
-			panic(FormatTokenIsNotMapped(string(bytes)))
-		},
-	))
-
-	return format
-}
-
-// parseToTime parses the value with given format to a time.Time
+// parseToTime parses the value with given format and the names of the default Locale to a time.Time
 // error if the value could not be parsed
-//
-// parseToTime panics if the formatToken is not mapped correctly
 func parseToTime(value string, format string, timezone Timezone) (time.Time, error) {
-	re := regexp.MustCompile(formatTokenRegex())
-
-	format = string(re.ReplaceAllFunc(
-		[]byte(format),
-		func(bytes []byte) []byte {
-			if FormatToken(bytes) == FormatToken(DayOfMonthOrdinal) {
-				// todo: prone to accidental replacements of said matches when used incidentally outside of 'Do' context
-				replacer := strings.NewReplacer(
-					"th", "",
-					"rd", "",
-					"st", "",
-					"nd", "",
-				)
-
-				value = replacer.Replace(value)
-
-				return []byte(DayOfMonthShort)
-			}
-
-			return bytes
-		},
-	))
-	format = translateFormat(format)
-
-	return time.ParseInLocation(format, value, timezone.Location())
+	return parseToTimeInLocale(value, format, timezone, defaultLocale())
 }
 
 // formatFromTime formats value as time.Time with given format to a string
+// Everything in format, which is not a FormatToken, is kept as it is
+//
+// formatFromTime panics if the formatToken is not mapped correctly
 func formatFromTime(value time.Time, format string) string {
-	format = translateFormat(format)
-
-	v := value.Format(format)
-
-	v = strings.ReplaceAll(v, string(GoDayOrdinal), dayOrdinal(value))
+	return formatFromTimeInLocale(value, format, defaultLocale())
+}
 
-	return v
+// formatFromTimeInLocale formats value as time.Time with given format in given Locale to a string
+//
+// formatFromTimeInLocale panics if the formatToken is not mapped correctly
+func formatFromTimeInLocale(value time.Time, format string, locale Locale) string {
+	var builder strings.Builder
+	for _, element := range compileLayout(format, locale) {
+		if element.token == "" {
+			builder.WriteString(element.literal)
+			continue
+		}
+		builder.WriteString(formatToken(value, element.token, locale))
+	}
+	return builder.String()
 }
 
-func dayOrdinal(t time.Time) string {
-	return humanize.Ordinal(t.Day())
+// formatToken formats value as given FormatToken in given Locale to a string
+//
+// formatToken panics if the formatToken is not mapped correctly
+func formatToken(value time.Time, token FormatToken, locale Locale) string {
+	if formatter, ok := formatTokenFormatters[token]; ok {
+		return formatter(value, locale)
+	}
+	if goFormatToken, ok := formatTokenMap[token]; ok {
+		return value.Format(string(goFormatToken))
+	}
+	panic(FormatTokenIsNotMapped(string(token)))
 }
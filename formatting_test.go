@@ -25,6 +25,49 @@ func TestParseToTimeOrdinal(t *testing.T) {
 	assert.Equal(t, time.Date(2011, 4, 5, 0, 0, 0, 0, time.UTC), actualResult)
 }
 
+func TestParseToTimeOrdinal_Suffix(t *testing.T) {
+	tests := []struct {
+		value    string
+		format   string
+		expected time.Time
+	}{
+		{"Thursday 1st August 2019", "dddd Do MMMM YYYY", time.Date(2019, 8, 1, 0, 0, 0, 0, time.UTC)},
+		{"Thursday 1st August", "dddd Do MMMM", time.Date(0, 8, 1, 0, 0, 0, 0, time.UTC)},
+		{"Friday 2nd August 2019", "dddd Do MMMM YYYY", time.Date(2019, 8, 2, 0, 0, 0, 0, time.UTC)},
+		{"Saturday 3rd August 2019", "dddd Do MMMM YYYY", time.Date(2019, 8, 3, 0, 0, 0, 0, time.UTC)},
+		{"Sunday 11th August 2019", "dddd Do MMMM YYYY", time.Date(2019, 8, 11, 0, 0, 0, 0, time.UTC)},
+		{"Wednesday 21st August 2019", "dddd Do MMMM YYYY", time.Date(2019, 8, 21, 0, 0, 0, 0, time.UTC)},
+		{"Thursday 22nd August 2019", "dddd Do MMMM YYYY", time.Date(2019, 8, 22, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		actualResult, err := parseToTime(test.value, test.format, UTC)
+		assert.NoError(t, err, test.value)
+		assert.Equal(t, test.expected, actualResult, test.value)
+	}
+
+	for _, value := range []string{"Thursday 1th August 2019", "Sunday 11st August 2019", "Thursday 1 August 2019"} {
+		_, err := parseToTime(value, "dddd Do MMMM YYYY", UTC)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestParseToTimeOrdinal_Locale(t *testing.T) {
+	actualResult, err := ParseLocale("1er août 2019", "Do MMMM YYYY", FrenchLocale)
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2019, 8, 1, 0, 0, 0, 0, UTC), actualResult)
+
+	actualResult, err = ParseLocale("2 août 2019", "Do MMMM YYYY", FrenchLocale)
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2019, 8, 2, 0, 0, 0, 0, UTC), actualResult)
+
+	actualResult, err = ParseLocale("Donnerstag, 1. August 2019", "dddd, Do MMMM YYYY", GermanLocale)
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2019, 8, 1, 0, 0, 0, 0, UTC), actualResult)
+
+	_, err = ParseLocale("1st August 2019", "Do MMMM YYYY", GermanLocale)
+	assert.Error(t, err)
+}
+
 func TestFormatFromTime(t *testing.T) {
 	actualResult := formatFromTime(
 		time.Date(2011, 4, 5, 15, 7, 8, 9, time.UTC),
@@ -54,6 +97,15 @@ func TestFormatFromTime(t *testing.T) {
 	)
 }
 
+func TestFormatFromTime_TwentyFourHour(t *testing.T) {
+	assert.Equal(t, "9:05 15:07", formatFromTime(time.Date(2011, 4, 5, 9, 5, 0, 0, time.UTC), "H:mm [15:07]"))
+	assert.Equal(t, "0", formatFromTime(time.Date(2011, 4, 5, 0, 0, 0, 0, time.UTC), "H"))
+
+	actual, err := ParseStrict("5.4.2011 9:05", "D.M.YYYY H:mm")
+	assert.NoError(t, err)
+	assert.Equal(t, NewUTCDateTime(2011, 4, 5, 9, 5, 0, 0), actual)
+}
+
 func TestOrdinal(t *testing.T) {
 	actualResult := formatFromTime(
 		time.Date(2011, 4, 5, 15, 7, 8, 9, time.UTC),
@@ -65,3 +117,51 @@ func TestOrdinal(t *testing.T) {
 		actualResult,
 	)
 }
+
+func TestFormatFromTime_Week(t *testing.T) {
+	actualResult := formatFromTime(
+		time.Date(2012, 12, 31, 15, 7, 8, 9, time.UTC),
+		"gggg-ww w GGGG-WW W",
+	)
+	assert.Equal(t, "2013-01 1 2013-01 1", actualResult)
+
+	SetDefaultWeekCalendar(SundayWeekCalendar)
+	defer SetDefaultWeekCalendar(IsoWeekCalendar)
+
+	actualResult = formatFromTime(
+		time.Date(2022, 1, 2, 15, 7, 8, 9, time.UTC),
+		"gggg-ww GGGG-WW",
+	)
+	assert.Equal(t, "2022-02 2021-52", actualResult)
+}
+
+func TestFormatFromTime_Literals(t *testing.T) {
+	actualResult := formatFromTime(
+		time.Date(2011, 4, 5, 15, 7, 8, 9, time.UTC),
+		"DD.MM.YYYY, 2006 01",
+	)
+	assert.Equal(t, "05.04.2011, 2006 01", actualResult)
+}
+
+func TestParseToTime_NotParsable(t *testing.T) {
+	_, err := parseToTime("2012 01", "YYYY ww", UTC)
+	assert.EqualError(t, err, "FormatToken: ww is not parsable")
+}
+
+func TestParseToTime_IsoWeek(t *testing.T) {
+	actualResult, err := parseToTime("2013-01", "GGGG-WW", UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2012, 12, 31, 0, 0, 0, 0, time.UTC), actualResult)
+
+	actualResult, err = parseToTime("2011-W14 Tue", "GGGG-[W]WW ddd", UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2011, 4, 5, 0, 0, 0, 0, time.UTC), actualResult)
+}
+
+func TestFormatFromTime_Escaped(t *testing.T) {
+	actualResult := formatFromTime(
+		time.Date(2011, 4, 5, 15, 7, 8, 9, time.UTC),
+		"[Today is] dddd [at] HH:mm [[YYYY]]",
+	)
+	assert.Equal(t, "Today is Tuesday at 15:07 [YYYY]", actualResult)
+}
@@ -1,6 +1,6 @@
 module github.com/bykof/gostradamus
 
-go 1.22
+go 1.23
 
 require (
 	github.com/dustin/go-humanize v1.0.1
@@ -0,0 +1,294 @@
+package gostradamus
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Observance returns by how many days a holiday on given weekday is moved to the day it is observed on
+type Observance func(weekday time.Weekday) int
+
+var (
+	// NearestWeekdayObservance moves holidays on saturday to friday and on sunday to monday, like in the US
+	NearestWeekdayObservance Observance = func(weekday time.Weekday) int {
+		switch weekday {
+		case time.Saturday:
+			return -1
+		case time.Sunday:
+			return 1
+		}
+		return 0
+	}
+
+	// NextWeekdayObservance moves holidays on saturday or sunday to the following monday, like in the UK
+	NextWeekdayObservance Observance = func(weekday time.Weekday) int {
+		switch weekday {
+		case time.Saturday:
+			return 2
+		case time.Sunday:
+			return 1
+		}
+		return 0
+	}
+
+	// SundayToMondayObservance moves holidays on sunday to monday
+	SundayToMondayObservance Observance = func(weekday time.Weekday) int {
+		if weekday == time.Sunday {
+			return 1
+		}
+		return 0
+	}
+
+	// SundayToSaturdayObservance moves holidays on sunday to saturday, like King's Day in the Netherlands
+	SundayToSaturdayObservance Observance = func(weekday time.Weekday) int {
+		if weekday == time.Sunday {
+			return -1
+		}
+		return 0
+	}
+)
+
+// HolidayRule computes the date of a named holiday in every year
+type HolidayRule struct {
+	name       string
+	date       func(year int) time.Time
+	observance Observance
+	since      int
+	until      int
+}
+
+// Holiday is a holiday of a year with the day it falls on and the day it is observed on.
+// Both are midnight in UTC.
+type Holiday struct {
+	Name     string
+	Date     DateTime
+	Observed DateTime
+}
+
+// HolidayRules is a HolidayCalendar of HolidayRules, which are created by NewHolidayRules and Add.
+// They cache the holidays of every year IsHoliday is asked for.
+type HolidayRules struct {
+	rules []HolidayRule
+	cache *holidayCache
+}
+
+// holidayCache caches the days the holidays fall on or are observed on per year
+type holidayCache struct {
+	mutex sync.Mutex
+	years map[int]map[civilDate]bool
+}
+
+// FixedHoliday returns a HolidayRule of a holiday on the same day every year
+//
+// For Example:
+//
+//	FixedHoliday("Christmas Day", time.December, 25)
+func FixedHoliday(name string, month time.Month, day int) HolidayRule {
+	return HolidayRule{
+		name: name,
+		date: func(year int) time.Time {
+			return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		},
+	}
+}
+
+// NthWeekdayHoliday returns a HolidayRule of a holiday on the nth weekday of a month.
+// Positive n count from the start of the month, negative n from its end.
+//
+// For Example:
+//
+//	NthWeekdayHoliday("Thanksgiving Day", time.November, time.Thursday, 4)
+func NthWeekdayHoliday(name string, month time.Month, weekday time.Weekday, n int) HolidayRule {
+	return HolidayRule{
+		name: name,
+		date: func(year int) time.Time {
+			if n < 0 {
+				last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+				return last.AddDate(0, 0, -daysSinceWeekday(last.Weekday(), weekday)+(n+1)*WeekInDays)
+			}
+			first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+			return first.AddDate(0, 0, daysSinceWeekday(weekday, first.Weekday())+(n-1)*WeekInDays)
+		},
+	}
+}
+
+// LastWeekdayHoliday returns a HolidayRule of a holiday on the last weekday of a month
+//
+// For Example:
+//
+//	LastWeekdayHoliday("Memorial Day", time.May, time.Monday)
+func LastWeekdayHoliday(name string, month time.Month, weekday time.Weekday) HolidayRule {
+	return NthWeekdayHoliday(name, month, weekday, -1)
+}
+
+// EasterHoliday returns a HolidayRule of a holiday given days after (or before) western Easter Sunday
+//
+// For Example:
+//
+//	EasterHoliday("Good Friday", -2)
+func EasterHoliday(name string, days int) HolidayRule {
+	return HolidayRule{
+		name: name,
+		date: func(year int) time.Time {
+			return EasterSunday(year).Time().AddDate(0, 0, days)
+		},
+	}
+}
+
+// EasterSunday returns the western (gregorian) Easter Sunday of given year at midnight in UTC
+func EasterSunday(year int) DateTime {
+	// anonymous gregorian algorithm
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return NewUTCDateTime(year, month, day, 0, 0, 0, 0)
+}
+
+// Name returns the name of the holiday
+func (r HolidayRule) Name() string {
+	return r.name
+}
+
+// Observed returns a new HolidayRule, which is observed according to given Observance
+func (r HolidayRule) Observed(observance Observance) HolidayRule {
+	r.observance = observance
+	return r
+}
+
+// Since returns a new HolidayRule, which applies from given year on
+func (r HolidayRule) Since(year int) HolidayRule {
+	r.since = year
+	return r
+}
+
+// Until returns a new HolidayRule, which applies up to given year, included
+func (r HolidayRule) Until(year int) HolidayRule {
+	r.until = year
+	return r
+}
+
+// Date returns the day of the holiday in given year at midnight in UTC
+// The returned bool is false if the HolidayRule does not apply in given year.
+func (r HolidayRule) Date(year int) (DateTime, bool) {
+	if (r.since != 0 && year < r.since) || (r.until != 0 && year > r.until) {
+		return DateTime{}, false
+	}
+	return DateTimeFromTime(r.date(year)), true
+}
+
+// NewHolidayRules returns new HolidayRules of given HolidayRules
+func NewHolidayRules(rules ...HolidayRule) HolidayRules {
+	return HolidayRules{rules: slices.Clone(rules), cache: &holidayCache{years: map[int]map[civilDate]bool{}}}
+}
+
+// Add returns new HolidayRules, which additionally contain given HolidayRules
+func (hr HolidayRules) Add(rules ...HolidayRule) HolidayRules {
+	return NewHolidayRules(append(slices.Clone(hr.rules), rules...)...)
+}
+
+// Rules returns a copy of the HolidayRules
+func (hr HolidayRules) Rules() []HolidayRule {
+	return slices.Clone(hr.rules)
+}
+
+// HolidaysInYear returns the holidays falling on a day of given year, sorted by date.
+// A holiday can be observed in a neighbouring year, like New Year's Day on a saturday is observed on the friday before.
+//
+// If a holiday is moved by its Observance to a day, which is another holiday,
+// it is moved further to the next free day from monday to friday.
+// Therefore in the UK Christmas Day on a saturday is observed on monday and Boxing Day on sunday on tuesday.
+func (hr HolidayRules) HolidaysInYear(year int) []Holiday {
+	var holidays []Holiday
+	var observances []Observance
+	taken := map[civilDate]bool{}
+	for _, rule := range hr.rules {
+		date, ok := rule.Date(year)
+		if !ok {
+			continue
+		}
+		holidays = append(holidays, Holiday{Name: rule.name, Date: date, Observed: date})
+		observances = append(observances, rule.observance)
+		taken[civilDateOf(date.Time())] = true
+	}
+
+	for _, i := range sortedHolidayIndexes(holidays) {
+		if observances[i] == nil {
+			continue
+		}
+		days := observances[i](holidays[i].Date.Time().Weekday())
+		if days == 0 {
+			continue
+		}
+		observed := holidays[i].Date.Time().AddDate(0, 0, days)
+		if taken[civilDateOf(observed)] {
+			for taken[civilDateOf(observed)] || observed.Weekday() == time.Saturday || observed.Weekday() == time.Sunday {
+				observed = observed.AddDate(0, 0, 1)
+			}
+		}
+		taken[civilDateOf(observed)] = true
+		holidays[i].Observed = DateTimeFromTime(observed)
+	}
+
+	slices.SortStableFunc(holidays, func(a Holiday, b Holiday) int {
+		return a.Date.Compare(b.Date)
+	})
+	return holidays
+}
+
+// IsHoliday checks if the day of given DateTime, in its own timezone, is the day a holiday falls on or is observed on
+func (hr HolidayRules) IsHoliday(dateTime DateTime) bool {
+	day := civilDateOf(dateTime.Time())
+	for year := day.year - 1; year <= day.year+1; year++ {
+		if hr.holidayDays(year)[day] {
+			return true
+		}
+	}
+	return false
+}
+
+// holidayDays returns the days the holidays of given year fall on or are observed on.
+// They are cached, unless the HolidayRules are the zero value.
+func (hr HolidayRules) holidayDays(year int) map[civilDate]bool {
+	if hr.cache == nil {
+		return holidayDaysOf(hr.HolidaysInYear(year))
+	}
+	hr.cache.mutex.Lock()
+	defer hr.cache.mutex.Unlock()
+	days, ok := hr.cache.years[year]
+	if !ok {
+		days = holidayDaysOf(hr.HolidaysInYear(year))
+		hr.cache.years[year] = days
+	}
+	return days
+}
+
+// holidayDaysOf returns the days the holidays fall on or are observed on
+func holidayDaysOf(holidays []Holiday) map[civilDate]bool {
+	days := make(map[civilDate]bool, 2*len(holidays))
+	for _, holiday := range holidays {
+		days[civilDateOf(holiday.Date.Time())] = true
+		days[civilDateOf(holiday.Observed.Time())] = true
+	}
+	return days
+}
+
+// sortedHolidayIndexes returns the indexes of the holidays sorted by date
+func sortedHolidayIndexes(holidays []Holiday) []int {
+	indexes := make([]int, len(holidays))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	slices.SortStableFunc(indexes, func(a int, b int) int {
+		return holidays[a].Date.Compare(holidays[b].Date)
+	})
+	return indexes
+}
@@ -0,0 +1,194 @@
+package gostradamus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// holidayDates returns the names, dates and observed dates of the holidays formatted as "name date observed"
+func holidayDates(holidays []Holiday) []string {
+	formatted := make([]string, len(holidays))
+	for i, holiday := range holidays {
+		formatted[i] = holiday.Name + " " + holiday.Date.Format("YYYY-MM-DD") + " " + holiday.Observed.Format("YYYY-MM-DD")
+	}
+	return formatted
+}
+
+func TestEasterSunday(t *testing.T) {
+	assert.Equal(t, NewUTCDateTime(1818, 3, 22, 0, 0, 0, 0), EasterSunday(1818))
+	assert.Equal(t, NewUTCDateTime(2019, 4, 21, 0, 0, 0, 0), EasterSunday(2019))
+	assert.Equal(t, NewUTCDateTime(2024, 3, 31, 0, 0, 0, 0), EasterSunday(2024))
+	assert.Equal(t, NewUTCDateTime(2025, 4, 20, 0, 0, 0, 0), EasterSunday(2025))
+	assert.Equal(t, NewUTCDateTime(2038, 4, 25, 0, 0, 0, 0), EasterSunday(2038))
+}
+
+func TestHolidayRule_Date(t *testing.T) {
+	actual, ok := FixedHoliday("Christmas Day", time.December, 25).Date(2012)
+	assert.True(t, ok)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 25, 0, 0, 0, 0), actual)
+
+	actual, _ = NthWeekdayHoliday("Thanksgiving Day", time.November, time.Thursday, 4).Date(2012)
+	assert.Equal(t, NewUTCDateTime(2012, 11, 22, 0, 0, 0, 0), actual)
+
+	actual, _ = NthWeekdayHoliday("Labor Day", time.September, time.Monday, 1).Date(2012)
+	assert.Equal(t, NewUTCDateTime(2012, 9, 3, 0, 0, 0, 0), actual)
+
+	actual, _ = NthWeekdayHoliday("Second to last friday", time.August, time.Friday, -2).Date(2012)
+	assert.Equal(t, NewUTCDateTime(2012, 8, 24, 0, 0, 0, 0), actual)
+
+	actual, _ = LastWeekdayHoliday("Memorial Day", time.May, time.Monday).Date(2012)
+	assert.Equal(t, NewUTCDateTime(2012, 5, 28, 0, 0, 0, 0), actual)
+
+	actual, _ = EasterHoliday("Good Friday", -2).Date(2024)
+	assert.Equal(t, NewUTCDateTime(2024, 3, 29, 0, 0, 0, 0), actual)
+
+	rule := FixedHoliday("Juneteenth", time.June, 19).Since(2021).Until(2030)
+	assert.Equal(t, "Juneteenth", rule.Name())
+	_, ok = rule.Date(2020)
+	assert.False(t, ok)
+	_, ok = rule.Date(2021)
+	assert.True(t, ok)
+	_, ok = rule.Date(2031)
+	assert.False(t, ok)
+}
+
+func TestObservance(t *testing.T) {
+	assert.Equal(t, -1, NearestWeekdayObservance(time.Saturday))
+	assert.Equal(t, 1, NearestWeekdayObservance(time.Sunday))
+	assert.Equal(t, 0, NearestWeekdayObservance(time.Friday))
+	assert.Equal(t, 2, NextWeekdayObservance(time.Saturday))
+	assert.Equal(t, 1, NextWeekdayObservance(time.Sunday))
+	assert.Equal(t, 0, SundayToMondayObservance(time.Saturday))
+	assert.Equal(t, 1, SundayToMondayObservance(time.Sunday))
+	assert.Equal(t, -1, SundayToSaturdayObservance(time.Sunday))
+}
+
+func TestHolidayRules_HolidaysInYear(t *testing.T) {
+	assert.Equal(
+		t,
+		[]string{
+			"New Year's Day 2021-01-01 2021-01-01",
+			"Birthday of Martin Luther King, Jr. 2021-01-18 2021-01-18",
+			"Washington's Birthday 2021-02-15 2021-02-15",
+			"Memorial Day 2021-05-31 2021-05-31",
+			"Juneteenth National Independence Day 2021-06-19 2021-06-18",
+			"Independence Day 2021-07-04 2021-07-05",
+			"Labor Day 2021-09-06 2021-09-06",
+			"Columbus Day 2021-10-11 2021-10-11",
+			"Veterans Day 2021-11-11 2021-11-11",
+			"Thanksgiving Day 2021-11-25 2021-11-25",
+			"Christmas Day 2021-12-25 2021-12-24",
+		},
+		holidayDates(USHolidays.HolidaysInYear(2021)),
+	)
+	assert.Len(t, USHolidays.HolidaysInYear(2020), 10)
+
+	// New Year's Day 2022 is observed in 2021
+	assert.Equal(t, "New Year's Day 2022-01-01 2021-12-31", holidayDates(USHolidays.HolidaysInYear(2022))[0])
+
+	// Colliding substitute days are moved further
+	assert.Equal(
+		t,
+		[]string{"Christmas Day 2021-12-25 2021-12-27", "Boxing Day 2021-12-26 2021-12-28"},
+		holidayDates(GBHolidays.HolidaysInYear(2021))[6:],
+	)
+	assert.Equal(
+		t,
+		[]string{"Christmas Day 2022-12-25 2022-12-27", "Boxing Day 2022-12-26 2022-12-26"},
+		holidayDates(GBHolidays.HolidaysInYear(2022))[6:],
+	)
+
+	assert.Equal(
+		t,
+		[]string{
+			"Neujahr 2024-01-01 2024-01-01",
+			"Karfreitag 2024-03-29 2024-03-29",
+			"Ostermontag 2024-04-01 2024-04-01",
+			"Tag der Arbeit 2024-05-01 2024-05-01",
+			"Christi Himmelfahrt 2024-05-09 2024-05-09",
+			"Pfingstmontag 2024-05-20 2024-05-20",
+			"Tag der Deutschen Einheit 2024-10-03 2024-10-03",
+			"1. Weihnachtstag 2024-12-25 2024-12-25",
+			"2. Weihnachtstag 2024-12-26 2024-12-26",
+		},
+		holidayDates(DEHolidays.HolidaysInYear(2024)),
+	)
+
+	assert.Len(t, FRHolidays.HolidaysInYear(2024), 11)
+	assert.Contains(t, holidayDates(NLHolidays.HolidaysInYear(2025)), "Koningsdag 2025-04-27 2025-04-26")
+	assert.Contains(t, holidayDates(NLHolidays.HolidaysInYear(2024)), "Koningsdag 2024-04-27 2024-04-27")
+	assert.Empty(t, HolidayRules{}.HolidaysInYear(2024))
+}
+
+func TestHolidayRules_IsHoliday(t *testing.T) {
+	assert.True(t, USHolidays.IsHoliday(NewUTCDateTime(2021, 7, 5, 12, 0, 0, 0)))
+	assert.True(t, USHolidays.IsHoliday(NewUTCDateTime(2021, 7, 4, 12, 0, 0, 0)))
+	assert.True(t, USHolidays.IsHoliday(NewUTCDateTime(2021, 12, 31, 12, 0, 0, 0)))
+	assert.False(t, USHolidays.IsHoliday(NewUTCDateTime(2021, 7, 6, 12, 0, 0, 0)))
+
+	// Days are compared in the timezone of the DateTime
+	assert.True(t, DEHolidays.IsHoliday(NewDateTime(2024, 10, 3, 0, 30, 0, 0, EuropeBerlin)))
+	assert.False(t, DEHolidays.IsHoliday(NewDateTime(2024, 10, 3, 0, 30, 0, 0, EuropeBerlin).InTimezone(UTC)))
+
+	custom := DEHolidays.Add(FixedHoliday("Reformationstag", time.October, 31))
+	assert.True(t, custom.IsHoliday(NewUTCDateTime(2024, 10, 31, 0, 0, 0, 0)))
+	assert.False(t, DEHolidays.IsHoliday(NewUTCDateTime(2024, 10, 31, 0, 0, 0, 0)))
+}
+
+func TestHolidayRules_IsHoliday_Cache(t *testing.T) {
+	calls := 0
+	rule := HolidayRule{name: "Counted", date: func(year int) time.Time {
+		calls++
+		return time.Date(year, time.March, 1, 0, 0, 0, 0, time.UTC)
+	}}
+	rules := NewHolidayRules(rule)
+	for day := 1; day <= 31; day++ {
+		rules.IsHoliday(NewUTCDateTime(2024, 3, day, 0, 0, 0, 0))
+	}
+	// the years 2023 to 2025 are computed once
+	assert.Equal(t, 3, calls)
+
+	// HolidayRules without cache compute the holidays again
+	calls = 0
+	assert.False(t, HolidayRules{rules: rules.rules}.IsHoliday(NewUTCDateTime(2024, 3, 2, 0, 0, 0, 0)))
+	assert.Equal(t, 3, calls)
+}
+
+func TestHolidayRules_Rules(t *testing.T) {
+	given := []HolidayRule{FixedHoliday("Some", time.March, 1)}
+	rules := NewHolidayRules(given...)
+	assert.True(t, rules.IsHoliday(NewUTCDateTime(2024, 3, 1, 0, 0, 0, 0)))
+
+	// changing the given or returned rules does not change the HolidayRules and their cached holidays
+	given[0] = FixedHoliday("Other", time.April, 1)
+	rules.Rules()[0] = FixedHoliday("Other", time.April, 1)
+	assert.True(t, rules.IsHoliday(NewUTCDateTime(2024, 3, 1, 0, 0, 0, 0)))
+	assert.False(t, rules.IsHoliday(NewUTCDateTime(2024, 4, 1, 0, 0, 0, 0)))
+
+	// changed rules become new HolidayRules with new holidays
+	changed := rules.Rules()
+	changed[0] = FixedHoliday("Other", time.April, 1)
+	rules = NewHolidayRules(changed...)
+	assert.False(t, rules.IsHoliday(NewUTCDateTime(2024, 3, 1, 0, 0, 0, 0)))
+	assert.True(t, rules.IsHoliday(NewUTCDateTime(2024, 4, 1, 0, 0, 0, 0)))
+	assert.Len(t, rules.Rules(), 1)
+}
+
+func TestHolidayRules_BusinessCalendar(t *testing.T) {
+	calendar := NewBusinessCalendar()
+	calendar.Holidays = GBHolidays
+
+	actual := calendar.AddBusinessDays(NewUTCDateTime(2021, 12, 24, 9, 0, 0, 0), 1)
+	assert.Equal(t, NewUTCDateTime(2021, 12, 29, 9, 0, 0, 0), actual)
+}
+
+func TestLookupHolidayRules(t *testing.T) {
+	actual, ok := LookupHolidayRules("de")
+	assert.True(t, ok)
+	assert.Equal(t, len(DEHolidays.Rules()), len(actual.Rules()))
+
+	_, ok = LookupHolidayRules("XX")
+	assert.False(t, ok)
+}
@@ -0,0 +1,95 @@
+package gostradamus
+
+import (
+	"strings"
+	"time"
+)
+
+// The built-in HolidayRules cover the current nationwide public holidays.
+// Regional holidays, historic rules and one-off changes are not included.
+var (
+	// USHolidays are the federal holidays of the United States
+	USHolidays = NewHolidayRules(
+		FixedHoliday("New Year's Day", time.January, 1).Observed(NearestWeekdayObservance),
+		NthWeekdayHoliday("Birthday of Martin Luther King, Jr.", time.January, time.Monday, 3),
+		NthWeekdayHoliday("Washington's Birthday", time.February, time.Monday, 3),
+		LastWeekdayHoliday("Memorial Day", time.May, time.Monday),
+		FixedHoliday("Juneteenth National Independence Day", time.June, 19).Since(2021).Observed(NearestWeekdayObservance),
+		FixedHoliday("Independence Day", time.July, 4).Observed(NearestWeekdayObservance),
+		NthWeekdayHoliday("Labor Day", time.September, time.Monday, 1),
+		NthWeekdayHoliday("Columbus Day", time.October, time.Monday, 2),
+		FixedHoliday("Veterans Day", time.November, 11).Observed(NearestWeekdayObservance),
+		NthWeekdayHoliday("Thanksgiving Day", time.November, time.Thursday, 4),
+		FixedHoliday("Christmas Day", time.December, 25).Observed(NearestWeekdayObservance),
+	)
+
+	// GBHolidays are the bank holidays of England and Wales
+	GBHolidays = NewHolidayRules(
+		FixedHoliday("New Year's Day", time.January, 1).Observed(NextWeekdayObservance),
+		EasterHoliday("Good Friday", -2),
+		EasterHoliday("Easter Monday", 1),
+		NthWeekdayHoliday("Early May bank holiday", time.May, time.Monday, 1),
+		LastWeekdayHoliday("Spring bank holiday", time.May, time.Monday),
+		LastWeekdayHoliday("Summer bank holiday", time.August, time.Monday),
+		FixedHoliday("Christmas Day", time.December, 25).Observed(NextWeekdayObservance),
+		FixedHoliday("Boxing Day", time.December, 26).Observed(NextWeekdayObservance),
+	)
+
+	// DEHolidays are the nationwide public holidays of Germany
+	DEHolidays = NewHolidayRules(
+		FixedHoliday("Neujahr", time.January, 1),
+		EasterHoliday("Karfreitag", -2),
+		EasterHoliday("Ostermontag", 1),
+		FixedHoliday("Tag der Arbeit", time.May, 1),
+		EasterHoliday("Christi Himmelfahrt", 39),
+		EasterHoliday("Pfingstmontag", 50),
+		FixedHoliday("Tag der Deutschen Einheit", time.October, 3),
+		FixedHoliday("1. Weihnachtstag", time.December, 25),
+		FixedHoliday("2. Weihnachtstag", time.December, 26),
+	)
+
+	// FRHolidays are the public holidays of metropolitan France without Alsace-Moselle
+	FRHolidays = NewHolidayRules(
+		FixedHoliday("Jour de l'an", time.January, 1),
+		EasterHoliday("Lundi de Pâques", 1),
+		FixedHoliday("Fête du Travail", time.May, 1),
+		FixedHoliday("Victoire 1945", time.May, 8),
+		EasterHoliday("Ascension", 39),
+		EasterHoliday("Lundi de Pentecôte", 50),
+		FixedHoliday("Fête nationale", time.July, 14),
+		FixedHoliday("Assomption", time.August, 15),
+		FixedHoliday("Toussaint", time.November, 1),
+		FixedHoliday("Armistice 1918", time.November, 11),
+		FixedHoliday("Noël", time.December, 25),
+	)
+
+	// NLHolidays are the public holidays of the Netherlands
+	NLHolidays = NewHolidayRules(
+		FixedHoliday("Nieuwjaarsdag", time.January, 1),
+		EasterHoliday("Goede Vrijdag", -2),
+		EasterHoliday("Eerste Paasdag", 0),
+		EasterHoliday("Tweede Paasdag", 1),
+		FixedHoliday("Koningsdag", time.April, 27).Since(2014).Observed(SundayToSaturdayObservance),
+		FixedHoliday("Bevrijdingsdag", time.May, 5),
+		EasterHoliday("Hemelvaartsdag", 39),
+		EasterHoliday("Eerste Pinksterdag", 49),
+		EasterHoliday("Tweede Pinksterdag", 50),
+		FixedHoliday("Eerste Kerstdag", time.December, 25),
+		FixedHoliday("Tweede Kerstdag", time.December, 26),
+	)
+
+	holidayRulesByCountry = map[string]HolidayRules{
+		"US": USHolidays,
+		"GB": GBHolidays,
+		"DE": DEHolidays,
+		"FR": FRHolidays,
+		"NL": NLHolidays,
+	}
+)
+
+// LookupHolidayRules returns the built-in HolidayRules of given ISO 3166-1 alpha-2 country code, like "DE"
+// The returned bool is false if there are no built-in HolidayRules for the country.
+func LookupHolidayRules(countryCode string) (HolidayRules, bool) {
+	rules, ok := holidayRulesByCountry[strings.ToUpper(countryCode)]
+	return rules, ok
+}
@@ -0,0 +1,343 @@
+package gostradamus
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// HumanResult is what a human date expression resolved to
+type HumanResult struct {
+	// DateTime is the resolved point in time or the start of the resolved span
+	DateTime DateTime
+	// Unit is the length of the span starting at DateTime, like UnitDay for "tomorrow".
+	// It is UnitNanosecond for points in time, like "3 hours ago".
+	Unit Unit
+}
+
+// HumanRule resolves a part of a human date expression
+type HumanRule struct {
+	// Pattern is matched against the start of the remaining value, which is lower case and has single spaces
+	Pattern *regexp.Regexp
+	// Resolve returns the HumanResult of the match, which modifies the HumanResult of the previous parts.
+	// The first part gets the reference as HumanResult with UnitNanosecond.
+	// The returned bool is false if the match can not be resolved.
+	Resolve func(match []string, result HumanResult) (HumanResult, bool)
+}
+
+// HumanParser parses human date expressions, like "tomorrow 9am" or "end of month", with its HumanRules.
+// Every part of the expression is resolved by the HumanRule with the longest match at its start,
+// the first of the HumanRules wins a tie.
+type HumanParser struct {
+	Rules []HumanRule
+}
+
+var (
+	humanNumbers = map[string]int{
+		"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+		"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+	}
+
+	humanUnits = map[string]Unit{
+		"second": UnitSecond, "sec": UnitSecond, "minute": UnitMinute, "min": UnitMinute,
+		"hour": UnitHour, "day": UnitDay, "week": UnitWeek, "fortnight": UnitWeek,
+		"month": UnitMonth, "quarter": UnitQuarter, "year": UnitYear,
+		"decade": UnitDecade, "century": UnitCentury, "centurie": UnitCentury,
+	}
+
+	humanWeekdays = map[string]time.Weekday{
+		"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday,
+		"thursday": time.Thursday, "friday": time.Friday, "saturday": time.Saturday,
+		"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "tues": time.Tuesday, "wed": time.Wednesday,
+		"thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+	}
+
+	humanNumberPattern  = `(\d+|an?|one|two|three|four|five|six|seven|eight|nine|ten|eleven|twelve)`
+	humanUnitPattern    = `(second|sec|minute|min|hour|day|week|fortnight|month|quarter|year|decade|centurie|century)s?`
+	humanWeekdayPattern = `(sunday|monday|tuesday|wednesday|thursday|friday|saturday|sun|mon|tues|tue|wed|thurs|thur|thu|fri|sat)`
+
+	// EnglishHumanRules are the HumanRules of NewHumanParser
+	EnglishHumanRules = []HumanRule{
+		NewHumanRule(`now|right now`, func(_ []string, result HumanResult) (HumanResult, bool) {
+			return result, true
+		}),
+		NewHumanRule(`at|on|the`, func(_ []string, result HumanResult) (HumanResult, bool) {
+			return result, true
+		}),
+		NewHumanRule(
+			`today|tomorrow|yesterday|day after tomorrow|the day after tomorrow|day before yesterday|the day before yesterday`,
+			func(match []string, result HumanResult) (HumanResult, bool) {
+				days := map[string]int{
+					"today": 0, "tomorrow": 1, "yesterday": -1,
+					"day after tomorrow": 2, "day before yesterday": -2,
+				}[strings.TrimPrefix(match[0], "the ")]
+				return humanDay(result, result.DateTime.FloorDay().ShiftDays(days)), true
+			},
+		),
+		NewHumanRule(`(this|next|last|previous|past) `+humanUnitPattern, func(match []string, result HumanResult) (HumanResult, bool) {
+			unit := humanUnits[match[2]]
+			if match[2] == "fortnight" {
+				return HumanResult{}, false
+			}
+			return HumanResult{DateTime: result.DateTime.Floor(unit).ShiftBy(unit, humanDirection(match[1])), Unit: unit}, true
+		}),
+		NewHumanRule(`((this|next|last|previous|past) )?`+humanWeekdayPattern, func(match []string, result HumanResult) (HumanResult, bool) {
+			weekday := humanWeekdays[match[3]]
+			day := result.DateTime.FloorDay()
+			switch match[2] {
+			case "this":
+				day = day.FloorWeek().ShiftDays(daysSinceWeekday(weekday, DefaultWeekCalendar().FirstDay))
+			case "last", "previous", "past":
+				// the weekday of the current week is skipped
+				days := daysSinceWeekday(day.Time().Weekday(), weekday)
+				if days == 0 {
+					days = WeekInDays
+				}
+				day = day.ShiftDays(-days)
+			default:
+				days := daysSinceWeekday(weekday, day.Time().Weekday())
+				if days == 0 {
+					days = WeekInDays
+				}
+				day = day.ShiftDays(days)
+			}
+			return humanDay(result, day), true
+		}),
+		NewHumanRule(`in `+humanNumberPattern+` `+humanUnitPattern, func(match []string, result HumanResult) (HumanResult, bool) {
+			return humanShift(result, match[1], match[2], 1)
+		}),
+		NewHumanRule(
+			humanNumberPattern+` `+humanUnitPattern+` (ago|before|earlier|from now|later|after|hence)`,
+			func(match []string, result HumanResult) (HumanResult, bool) {
+				direction := 1
+				if match[3] == "ago" || match[3] == "before" || match[3] == "earlier" {
+					direction = -1
+				}
+				return humanShift(result, match[1], match[2], direction)
+			},
+		),
+		NewHumanRule(
+			`(start|beginning|end) of (the )?((this|next|last|previous|past) )?`+humanUnitPattern,
+			func(match []string, result HumanResult) (HumanResult, bool) {
+				unit := humanUnits[match[5]]
+				if match[5] == "fortnight" {
+					return HumanResult{}, false
+				}
+				dateTime := result.DateTime.ShiftBy(unit, humanDirection(match[4]))
+				if match[1] == "end" {
+					return HumanResult{DateTime: dateTime.Ceil(unit), Unit: UnitNanosecond}, true
+				}
+				return HumanResult{DateTime: dateTime.Floor(unit), Unit: UnitNanosecond}, true
+			},
+		),
+		NewHumanRule(`noon|midday|midnight`, func(match []string, result HumanResult) (HumanResult, bool) {
+			hour := 12
+			if match[0] == "midnight" {
+				hour = 0
+			}
+			return humanTime(result, hour, 0, 0, UnitHour), true
+		}),
+		NewHumanRule(`(\d{1,2})(:(\d{2}))?(:(\d{2}))? ?(am|pm|a\.m\.|p\.m\.)`, func(match []string, result HumanResult) (HumanResult, bool) {
+			hour, minute, second, unit := humanClock(match[1], match[3], match[5])
+			if hour < 1 || hour > 12 {
+				return HumanResult{}, false
+			}
+			hour %= 12
+			if strings.HasPrefix(match[6], "p") {
+				hour += 12
+			}
+			return humanTime(result, hour, minute, second, unit), minute < 60 && second < 60
+		}),
+		NewHumanRule(`(\d{1,2}):(\d{2})(:(\d{2}))?`, func(match []string, result HumanResult) (HumanResult, bool) {
+			hour, minute, second, unit := humanClock(match[1], match[2], match[4])
+			return humanTime(result, hour, minute, second, unit), hour < 24 && minute < 60 && second < 60
+		}),
+		NewHumanRule(`\d{4}-\d{2}-\d{2}`, func(match []string, result HumanResult) (HumanResult, bool) {
+			date, err := time.Parse("2006-01-02", match[0])
+			if err != nil {
+				return HumanResult{}, false
+			}
+			dateTime := DateTimeFromTime(time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, result.DateTime.Time().Location()))
+			return humanDay(result, dateTime), true
+		}),
+	}
+)
+
+// NewHumanRule returns a new HumanRule with the compiled pattern and given resolve function
+//
+// NewHumanRule panics if the pattern is not a valid regular expression
+func NewHumanRule(pattern string, resolve func(match []string, result HumanResult) (HumanResult, bool)) HumanRule {
+	return HumanRule{Pattern: regexp.MustCompile(pattern), Resolve: resolve}
+}
+
+// NewHumanParser returns a new HumanParser with a copy of the EnglishHumanRules
+func NewHumanParser() HumanParser {
+	return HumanParser{Rules: append([]HumanRule{}, EnglishHumanRules...)}
+}
+
+// Add returns a new HumanParser, which additionally uses given HumanRules
+func (p HumanParser) Add(rules ...HumanRule) HumanParser {
+	return HumanParser{Rules: append(append([]HumanRule{}, p.Rules...), rules...)}
+}
+
+// Parse resolves a human date expression relative to reference into a HumanResult
+//
+// For Example with reference 2020-01-15 12:00:00:
+//
+//	"tomorrow 9am" becomes 2020-01-16 09:00:00 with UnitHour
+//	"3 days ago" becomes 2020-01-12 12:00:00 with UnitNanosecond
+//	"last quarter" becomes 2019-10-01 00:00:00 with UnitQuarter
+func (p HumanParser) Parse(value string, reference DateTime) (HumanResult, error) {
+	result := HumanResult{DateTime: reference, Unit: UnitNanosecond}
+	rest := strings.ToLower(strings.Join(strings.Fields(value), " "))
+	if rest == "" {
+		return HumanResult{}, HumanDateIsNotParsable(value)
+	}
+	for rest != "" {
+		rule, match, ok := p.longestMatch(rest)
+		if !ok {
+			return HumanResult{}, HumanDateIsNotParsable(value)
+		}
+		if result, ok = rule.Resolve(match, result); !ok {
+			return HumanResult{}, HumanDateIsNotParsable(value)
+		}
+		rest = strings.TrimLeft(rest[len(match[0]):], " ,")
+	}
+	return result, nil
+}
+
+// longestMatch returns the HumanRule with the longest match at the start of value, which ends at a word boundary
+func (p HumanParser) longestMatch(value string) (HumanRule, []string, bool) {
+	var longestRule HumanRule
+	var longestMatch []string
+	for _, rule := range p.Rules {
+		indexes := rule.Pattern.FindStringSubmatchIndex(value)
+		if indexes == nil || indexes[0] != 0 || indexes[1] == 0 || !isWordBoundary(value, indexes[1]) {
+			continue
+		}
+		if longestMatch == nil || indexes[1] > len(longestMatch[0]) {
+			longestRule, longestMatch = rule, submatches(value, indexes)
+		}
+	}
+	return longestRule, longestMatch, longestMatch != nil
+}
+
+// ParseHuman resolves a human date expression in english relative to reference into a DateTime.
+// Expressions of spans, like "tomorrow" or "next month", resolve to the start of the span.
+//
+// For Example with reference 2020-01-15 12:00:00:
+//
+//	"next friday" becomes 2020-01-17 00:00:00
+//	"end of month" becomes 2020-01-31 23:59:59.999999999
+func ParseHuman(value string, reference DateTime) (DateTime, error) {
+	result, err := NewHumanParser().Parse(value, reference)
+	return result.DateTime, err
+}
+
+// ParseHumanInterval resolves a human date expression in english relative to reference into an Interval.
+// Expressions of points in time, like "3 days ago", resolve to an Interval of one nanosecond.
+//
+// For Example with reference 2020-01-15 12:00:00:
+//
+//	"last quarter" becomes [2019-10-01 00:00:00, 2020-01-01 00:00:00)
+func ParseHumanInterval(value string, reference DateTime) (Interval, error) {
+	result, err := NewHumanParser().Parse(value, reference)
+	if err != nil {
+		return Interval{}, err
+	}
+	return result.Interval(), nil
+}
+
+// Interval returns the Interval of the span of the HumanResult
+func (r HumanResult) Interval() Interval {
+	return NewInterval(r.DateTime, r.DateTime.ShiftBy(r.Unit, 1))
+}
+
+// humanDirection returns the shift of the words this, next and last
+func humanDirection(word string) int {
+	switch word {
+	case "next":
+		return 1
+	case "last", "previous", "past":
+		return -1
+	}
+	return 0
+}
+
+// humanShift shifts the HumanResult by the count and unit names.
+// The returned bool is false if the count does not fit into an int.
+func humanShift(result HumanResult, countName string, unitName string, direction int) (HumanResult, bool) {
+	count, ok := humanNumbers[countName]
+	if !ok {
+		var err error
+		if count, err = strconv.Atoi(countName); err != nil {
+			return HumanResult{}, false
+		}
+	}
+	unit := humanUnits[unitName]
+	if unitName == "fortnight" {
+		count *= 2
+	}
+	result.DateTime = result.DateTime.ShiftBy(unit, direction*count)
+	return result, true
+}
+
+// humanClock returns the hour, minute and second of the matched clock and the Unit of its precision
+func humanClock(hourMatch string, minuteMatch string, secondMatch string) (int, int, int, Unit) {
+	hour, _ := strconv.Atoi(hourMatch)
+	minute, _ := strconv.Atoi(minuteMatch)
+	second, _ := strconv.Atoi(secondMatch)
+	switch {
+	case secondMatch != "":
+		return hour, minute, second, UnitSecond
+	case minuteMatch != "":
+		return hour, minute, second, UnitMinute
+	}
+	return hour, minute, second, UnitHour
+}
+
+// humanDay returns the HumanResult of the day starting at day.
+// A wall clock resolved by a previous part, like "9am" of "9am tomorrow", is kept on day.
+func humanDay(result HumanResult, day DateTime) HumanResult {
+	if result.Unit < UnitSecond || result.Unit > UnitHour {
+		return HumanResult{DateTime: day, Unit: UnitDay}
+	}
+	clock := result.DateTime.Time()
+	return humanTime(HumanResult{DateTime: day}, clock.Hour(), clock.Minute(), clock.Second(), result.Unit)
+}
+
+// humanTime sets the wall clock of the HumanResult on its day
+func humanTime(result HumanResult, hour int, minute int, second int, unit Unit) HumanResult {
+	t := result.DateTime.Time()
+	return HumanResult{
+		DateTime: DateTimeFromTime(time.Date(t.Year(), t.Month(), t.Day(), hour, minute, second, 0, t.Location())),
+		Unit:     unit,
+	}
+}
+
+// isWordBoundary checks if the index of value is the end of value or not within a word
+func isWordBoundary(value string, index int) bool {
+	if index >= len(value) {
+		return true
+	}
+	before, _ := utf8.DecodeLastRuneInString(value[:index])
+	after, _ := utf8.DecodeRuneInString(value[index:])
+	isWord := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	}
+	return !isWord(before) || !isWord(after)
+}
+
+// submatches returns the submatches of value at given indexes, like FindStringSubmatch
+func submatches(value string, indexes []int) []string {
+	match := make([]string, len(indexes)/2)
+	for i := range match {
+		if indexes[2*i] >= 0 {
+			match[i] = value[indexes[2*i]:indexes[2*i+1]]
+		}
+	}
+	return match
+}
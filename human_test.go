@@ -0,0 +1,138 @@
+package gostradamus
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHuman(t *testing.T) {
+	// 2020-01-15 is a wednesday
+	reference := NewDateTime(2020, 1, 15, 12, 30, 0, 0, EuropeBerlin)
+	date := func(year int, month int, day int, hour int, minute int, second int, nanosecond int) DateTime {
+		return NewDateTime(year, month, day, hour, minute, second, nanosecond, EuropeBerlin)
+	}
+
+	tests := map[string]DateTime{
+		"now":                       reference,
+		"today":                     date(2020, 1, 15, 0, 0, 0, 0),
+		"Tomorrow":                  date(2020, 1, 16, 0, 0, 0, 0),
+		"yesterday":                 date(2020, 1, 14, 0, 0, 0, 0),
+		"the day after tomorrow":    date(2020, 1, 17, 0, 0, 0, 0),
+		"tomorrow 9am":              date(2020, 1, 16, 9, 0, 0, 0),
+		"tomorrow at 9:15 pm":       date(2020, 1, 16, 21, 15, 0, 0),
+		"yesterday, 14:30":          date(2020, 1, 14, 14, 30, 0, 0),
+		"today at noon":             date(2020, 1, 15, 12, 0, 0, 0),
+		"12am":                      date(2020, 1, 15, 0, 0, 0, 0),
+		"friday":                    date(2020, 1, 17, 0, 0, 0, 0),
+		"next friday":               date(2020, 1, 17, 0, 0, 0, 0),
+		"next wednesday":            date(2020, 1, 22, 0, 0, 0, 0),
+		"last friday":               date(2020, 1, 10, 0, 0, 0, 0),
+		"last wed":                  date(2020, 1, 8, 0, 0, 0, 0),
+		"this monday":               date(2020, 1, 13, 0, 0, 0, 0),
+		"next mon 8:00":             date(2020, 1, 20, 8, 0, 0, 0),
+		"3 days ago":                date(2020, 1, 12, 12, 30, 0, 0),
+		"two weeks ago":             date(2020, 1, 1, 12, 30, 0, 0),
+		"an hour from now":          date(2020, 1, 15, 13, 30, 0, 0),
+		"in 2 hours":                date(2020, 1, 15, 14, 30, 0, 0),
+		"in a fortnight":            date(2020, 1, 29, 12, 30, 0, 0),
+		"end of month":              date(2020, 1, 31, 23, 59, 59, 999999999),
+		"end of next month":         date(2020, 2, 29, 23, 59, 59, 999999999),
+		"beginning of the week":     date(2020, 1, 13, 0, 0, 0, 0),
+		"start of last year":        date(2019, 1, 1, 0, 0, 0, 0),
+		"last quarter":              date(2019, 10, 1, 0, 0, 0, 0),
+		"this month":                date(2020, 1, 1, 0, 0, 0, 0),
+		"next year":                 date(2021, 1, 1, 0, 0, 0, 0),
+		"2020-03-01 10:00":          date(2020, 3, 1, 10, 0, 0, 0),
+		"  next   week ":            date(2020, 1, 20, 0, 0, 0, 0),
+		"next week tuesday":         date(2020, 1, 21, 0, 0, 0, 0),
+		"day before yesterday 6 pm": date(2020, 1, 13, 18, 0, 0, 0),
+		"9am tomorrow":              date(2020, 1, 16, 9, 0, 0, 0),
+		"noon tomorrow":             date(2020, 1, 16, 12, 0, 0, 0),
+		"next friday 5pm":           date(2020, 1, 17, 17, 0, 0, 0),
+		"5pm next friday":           date(2020, 1, 17, 17, 0, 0, 0),
+		"14:30 yesterday":           date(2020, 1, 14, 14, 30, 0, 0),
+		"2020-02-01 10:00":          date(2020, 2, 1, 10, 0, 0, 0),
+		"10:00 2020-02-01":          date(2020, 2, 1, 10, 0, 0, 0),
+	}
+	for value, expected := range tests {
+		actual, err := ParseHuman(value, reference)
+		assert.NoError(t, err, value)
+		assert.Equal(t, expected, actual, value)
+	}
+}
+
+func TestParseHuman_Error(t *testing.T) {
+	reference := NewUTCDateTime(2020, 1, 15, 12, 30, 0, 0)
+	values := []string{
+		"", "someday", "tomorrow maybe", "25:00", "13pm", "mondays", "3 days", "next fortnight",
+		"in 99999999999999999999 days", "99999999999999999999 hours ago",
+	}
+	for _, value := range values {
+		_, err := ParseHuman(value, reference)
+		assert.EqualError(t, err, "HumanDate: "+value+" is not parsable", value)
+	}
+}
+
+func TestParseHumanInterval(t *testing.T) {
+	reference := NewUTCDateTime(2020, 1, 15, 12, 30, 0, 0)
+
+	actual, err := ParseHumanInterval("last quarter", reference)
+	assert.NoError(t, err)
+	assert.Equal(t, NewInterval(NewUTCDateTime(2019, 10, 1, 0, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0)), actual)
+
+	actual, err = ParseHumanInterval("tomorrow 9am", reference)
+	assert.NoError(t, err)
+	assert.Equal(t, NewInterval(NewUTCDateTime(2020, 1, 16, 9, 0, 0, 0), NewUTCDateTime(2020, 1, 16, 10, 0, 0, 0)), actual)
+
+	actual, err = ParseHumanInterval("3 hours ago", reference)
+	assert.NoError(t, err)
+	assert.Equal(t, NewInterval(reference.ShiftHours(-3), reference.ShiftHours(-3).ShiftNanoseconds(1)), actual)
+
+	_, err = ParseHumanInterval("someday", reference)
+	assert.EqualError(t, err, "HumanDate: someday is not parsable")
+}
+
+func TestHumanParser_Add(t *testing.T) {
+	reference := NewUTCDateTime(2020, 1, 15, 12, 30, 0, 0)
+	parser := NewHumanParser().Add(
+		NewHumanRule(`christmas`, func(_ []string, result HumanResult) (HumanResult, bool) {
+			return HumanResult{DateTime: NewUTCDateTime(result.DateTime.Year(), 12, 25, 0, 0, 0, 0), Unit: UnitDay}, true
+		}),
+		HumanRule{
+			Pattern: regexp.MustCompile(`eod`),
+			Resolve: func(_ []string, result HumanResult) (HumanResult, bool) {
+				return HumanResult{DateTime: result.DateTime.FloorDay().ShiftHours(17), Unit: UnitNanosecond}, true
+			},
+		},
+	)
+
+	actual, err := parser.Parse("Christmas 6pm", reference)
+	assert.NoError(t, err)
+	assert.Equal(t, HumanResult{DateTime: NewUTCDateTime(2020, 12, 25, 18, 0, 0, 0), Unit: UnitHour}, actual)
+
+	actual, err = parser.Parse("tomorrow eod", reference)
+	assert.NoError(t, err)
+	assert.Equal(t, NewUTCDateTime(2020, 1, 16, 17, 0, 0, 0), actual.DateTime)
+
+	// the parser, which was added to, is not changed
+	_, err = NewHumanParser().Parse("christmas", reference)
+	assert.Error(t, err)
+}
+
+func TestNewHumanParser(t *testing.T) {
+	reference := NewUTCDateTime(2020, 1, 15, 12, 30, 0, 0)
+	parser := NewHumanParser()
+	parser.Rules[0] = NewHumanRule(`now`, func(_ []string, _ HumanResult) (HumanResult, bool) {
+		return HumanResult{}, false
+	})
+
+	_, err := parser.Parse("now", reference)
+	assert.Error(t, err)
+
+	// the EnglishHumanRules are not changed
+	actual, err := ParseHuman("now", reference)
+	assert.NoError(t, err)
+	assert.Equal(t, reference, actual)
+}
@@ -0,0 +1,362 @@
+// Command gencldr generates the names and the date and time patterns of the built-in Locales of gostradamus
+// from the JSON data of the Unicode CLDR.
+//
+// Usage:
+//
+//	go run ./internal/gencldr [-cldr source] [-o file]
+//
+// The source is the cldr-json directory of a checkout of https://github.com/unicode-org/cldr-json
+// or its URL, by default the one of the release 44.0.0 on GitHub.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const defaultSource = "https://raw.githubusercontent.com/unicode-org/cldr-json/44.0.0/cldr-json"
+
+// languages are the language codes of the built-in Locales
+var languages = []string{"en", "de", "fr", "es", "it", "nl", "pt", "ja"}
+
+// locale holds the fields of a Locale, which are generated
+type locale struct {
+	Code            string
+	Months          []string
+	MonthsShort     []string
+	Weekdays        []string
+	WeekdaysShort   []string
+	Meridiem        []string
+	DateFormats     []string
+	TimeFormats     []string
+	DateTimeFormats []string
+}
+
+// caGregorian is the content of ca-gregorian.json
+type caGregorian struct {
+	Main map[string]struct {
+		Dates struct {
+			Calendars struct {
+				Gregorian gregorian `json:"gregorian"`
+			} `json:"calendars"`
+		} `json:"dates"`
+	} `json:"main"`
+}
+
+type gregorian struct {
+	Months          names  `json:"months"`
+	Days            names  `json:"days"`
+	DayPeriods      names  `json:"dayPeriods"`
+	DateFormats     styles `json:"dateFormats"`
+	TimeFormats     styles `json:"timeFormats"`
+	DateTimeFormats styles `json:"dateTimeFormats"`
+	// DateTimeFormatsAtTime combine a date with a time at that date, like "{1} 'at' {0}"
+	DateTimeFormatsAtTime struct {
+		Standard styles `json:"standard"`
+	} `json:"dateTimeFormats-atTime"`
+}
+
+// names are the names by context, like "format", width, like "wide", and key, like "1" or "sun"
+type names map[string]map[string]map[string]string
+
+// styles are the patterns of the four lengths
+type styles struct {
+	Short  string `json:"short"`
+	Medium string `json:"medium"`
+	Long   string `json:"long"`
+	Full   string `json:"full"`
+}
+
+// numbers is the content of numbers.json
+type numbers struct {
+	Main map[string]struct {
+		Numbers map[string]json.RawMessage `json:"numbers"`
+	} `json:"main"`
+}
+
+func main() {
+	source := flag.String("cldr", defaultSource, "cldr-json directory or URL")
+	output := flag.String("o", "locales_cldr.go", "generated file")
+	flag.Parse()
+
+	locales := make([]locale, 0, len(languages))
+	for _, language := range languages {
+		l, err := loadLocale(*source, language)
+		if err != nil {
+			log.Fatal(err)
+		}
+		locales = append(locales, l)
+	}
+	src, err := render(locales)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// loadLocale reads the locale of given language from ca-gregorian.json and numbers.json of the source
+func loadLocale(source string, language string) (locale, error) {
+	var calendar caGregorian
+	if err := readJSON(source, "cldr-dates-full/main/"+language+"/ca-gregorian.json", &calendar); err != nil {
+		return locale{}, err
+	}
+	var number numbers
+	if err := readJSON(source, "cldr-numbers-full/main/"+language+"/numbers.json", &number); err != nil {
+		return locale{}, err
+	}
+	timeSeparator, err := timeSeparatorOf(number.Main[language].Numbers)
+	if err != nil {
+		return locale{}, fmt.Errorf("%s: %w", language, err)
+	}
+
+	g := calendar.Main[language].Dates.Calendars.Gregorian
+	l := locale{Code: language}
+	fields := []struct {
+		target *[]string
+		names  names
+		width  string
+		keys   []string
+	}{
+		{&l.Months, g.Months, "wide", []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"}},
+		{&l.MonthsShort, g.Months, "abbreviated", []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"}},
+		{&l.Weekdays, g.Days, "wide", []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+		{&l.WeekdaysShort, g.Days, "abbreviated", []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+		{&l.Meridiem, g.DayPeriods, "abbreviated", []string{"am", "pm"}},
+	}
+	for _, field := range fields {
+		for _, key := range field.keys {
+			name := field.names["format"][field.width][key]
+			if name == "" {
+				return locale{}, fmt.Errorf("%s: the %s name %q is missing", language, field.width, key)
+			}
+			*field.target = append(*field.target, name)
+		}
+	}
+
+	// full and long dates are combined with the time at that date like ICU does
+	dateTimeFormats := g.DateTimeFormats
+	if atTime := g.DateTimeFormatsAtTime.Standard; atTime.Full != "" && atTime.Long != "" {
+		dateTimeFormats.Full, dateTimeFormats.Long = atTime.Full, atTime.Long
+	}
+	patterns := []struct {
+		target *[]string
+		styles styles
+	}{
+		{&l.DateFormats, g.DateFormats},
+		{&l.TimeFormats, g.TimeFormats},
+		{&l.DateTimeFormats, dateTimeFormats},
+	}
+	for _, pattern := range patterns {
+		for _, p := range []string{pattern.styles.Short, pattern.styles.Medium, pattern.styles.Long, pattern.styles.Full} {
+			converted, err := convertPattern(p, timeSeparator)
+			if err != nil {
+				return locale{}, fmt.Errorf("%s: %w", language, err)
+			}
+			*pattern.target = append(*pattern.target, converted)
+		}
+	}
+	return l, nil
+}
+
+// timeSeparatorOf returns the time separator of the default numbering system,
+// which has to use the latin digits FormatTokens are formatted with
+func timeSeparatorOf(numbers map[string]json.RawMessage) (string, error) {
+	var system string
+	if err := json.Unmarshal(numbers["defaultNumberingSystem"], &system); err != nil {
+		return "", fmt.Errorf("the default numbering system is missing: %w", err)
+	}
+	if system != "latn" {
+		return "", fmt.Errorf("the default numbering system %q is not supported", system)
+	}
+	var symbols struct {
+		TimeSeparator string `json:"timeSeparator"`
+	}
+	if err := json.Unmarshal(numbers["symbols-numberSystem-latn"], &symbols); err != nil {
+		return "", fmt.Errorf("the symbols of latn are missing: %w", err)
+	}
+	if symbols.TimeSeparator == "" {
+		return ":", nil
+	}
+	return symbols.TimeSeparator, nil
+}
+
+// readJSON decodes the JSON file at path of the source directory or URL into v
+func readJSON(source string, path string, v any) error {
+	var data []byte
+	var err error
+	if strings.HasPrefix(source, "https://") || strings.HasPrefix(source, "http://") {
+		data, err = download(source + "/" + path)
+	} else {
+		data, err = os.ReadFile(filepath.Join(source, filepath.FromSlash(path)))
+	}
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+func download(url string) ([]byte, error) {
+	response, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", url, response.Status)
+	}
+	return io.ReadAll(response.Body)
+}
+
+// patternFields are the FormatTokens of the CLDR pattern fields by letter and count of letters.
+// The long name of a timezone has no FormatToken and becomes the abbreviation.
+var patternFields = map[string]string{
+	"y": "YYYY", "yy": "YY", "yyyy": "YYYY",
+	"M": "M", "MM": "MM", "MMM": "MMM", "MMMM": "MMMM",
+	"L": "M", "LL": "MM", "LLL": "MMM", "LLLL": "MMMM",
+	"d": "D", "dd": "DD",
+	"E": "ddd", "EE": "ddd", "EEE": "ddd", "EEEE": "dddd",
+	"c": "ddd", "ccc": "ddd", "cccc": "dddd",
+	"a": "A",
+	"h": "h", "hh": "hh",
+	"H": "H", "HH": "HH",
+	"m": "m", "mm": "mm",
+	"s": "s", "ss": "ss",
+	"z": "ZZZ", "zz": "ZZZ", "zzz": "ZZZ", "zzzz": "ZZZ",
+}
+
+// convertPattern converts a CLDR date, time or date time pattern into a format of FormatTokens.
+// The placeholders {1} and {0} of date time patterns become {date} and {time}.
+func convertPattern(pattern string, timeSeparator string) (string, error) {
+	var result, literal strings.Builder
+	flush := func() {
+		result.WriteString(escapeLiteral(literal.String()))
+		literal.Reset()
+	}
+
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\'':
+			if i+1 < len(runes) && runes[i+1] == '\'' {
+				literal.WriteRune('\'')
+				i++
+				continue
+			}
+			for i++; i < len(runes); i++ {
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						literal.WriteRune('\'')
+						i++
+						continue
+					}
+					break
+				}
+				literal.WriteRune(runes[i])
+			}
+			if i == len(runes) {
+				return "", fmt.Errorf("pattern %q has an unterminated quote", pattern)
+			}
+		case r == '{':
+			end := i
+			for end < len(runes) && runes[end] != '}' {
+				end++
+			}
+			if end == len(runes) {
+				return "", fmt.Errorf("pattern %q has an unterminated placeholder", pattern)
+			}
+			placeholder := string(runes[i : end+1])
+			flush()
+			switch placeholder {
+			case "{1}":
+				result.WriteString("{date}")
+			case "{0}":
+				result.WriteString("{time}")
+			default:
+				return "", fmt.Errorf("pattern %q has the unknown placeholder %s", pattern, placeholder)
+			}
+			i = end
+		case r == ':':
+			literal.WriteString(timeSeparator)
+		case isASCIILetter(r):
+			count := 1
+			for i+count < len(runes) && runes[i+count] == r {
+				count++
+			}
+			field := strings.Repeat(string(r), count)
+			token, ok := patternFields[field]
+			if !ok {
+				return "", fmt.Errorf("pattern %q has the unsupported field %s", pattern, field)
+			}
+			flush()
+			result.WriteString(token)
+			i += count - 1
+		default:
+			literal.WriteRune(r)
+		}
+	}
+	flush()
+	return result.String(), nil
+}
+
+// escapeLiteral puts the part of a literal from its first to its last ASCII letter into square brackets,
+// so it is not read as FormatTokens
+func escapeLiteral(literal string) string {
+	first := strings.IndexFunc(literal, isASCIILetter)
+	if first < 0 {
+		return literal
+	}
+	last := strings.LastIndexFunc(literal, isASCIILetter)
+	return literal[:first] + "[" + literal[first:last+1] + "]" + literal[last+1:]
+}
+
+func isASCIILetter(r rune) bool {
+	return ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+}
+
+// render returns the formatted Go source of the locales
+func render(locales []locale) ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteString("// Code generated by go run ./internal/gencldr; DO NOT EDIT.\n\n")
+	buffer.WriteString("package gostradamus\n\n")
+	buffer.WriteString("// cldrLocales are the names and the date and time patterns of the Unicode CLDR version 44\n")
+	buffer.WriteString("// of the built-in Locales by language code\n")
+	buffer.WriteString("var cldrLocales = map[string]Locale{\n")
+	for _, l := range locales {
+		fmt.Fprintf(&buffer, "%q: {\n", l.Code)
+		fmt.Fprintf(&buffer, "Code: %q,\n", l.Code)
+		writeArray(&buffer, "Months", l.Months)
+		writeArray(&buffer, "MonthsShort", l.MonthsShort)
+		writeArray(&buffer, "Weekdays", l.Weekdays)
+		writeArray(&buffer, "WeekdaysShort", l.WeekdaysShort)
+		writeArray(&buffer, "Meridiem", l.Meridiem)
+		writeArray(&buffer, "DateFormats", l.DateFormats)
+		writeArray(&buffer, "TimeFormats", l.TimeFormats)
+		writeArray(&buffer, "DateTimeFormats", l.DateTimeFormats)
+		buffer.WriteString("},\n")
+	}
+	buffer.WriteString("}\n")
+	return format.Source(buffer.Bytes())
+}
+
+func writeArray(buffer *bytes.Buffer, field string, values []string) {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	fmt.Fprintf(buffer, "%s: [%d]string{%s},\n", field, len(values), strings.Join(quoted, ", "))
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertPattern(t *testing.T) {
+	for pattern, expected := range map[string]string{
+		"EEEE, MMMM d, y":     "dddd, MMMM D, YYYY",
+		"dd.MM.yy":            "DD.MM.YY",
+		"d 'de' MMMM 'de' y":  "D [de] MMMM [de] YYYY",
+		"y年M月d日EEEE":          "YYYY年M月D日dddd",
+		"h:mm:ss\u202fa zzzz": "h:mm:ss\u202fA ZZZ",
+		"H時mm分ss秒 zzzz":       "H時mm分ss秒 ZZZ",
+		"H:mm:ss (zzzz)":      "H:mm:ss (ZZZ)",
+		"{1} 'at' {0}":        "{date} [at] {time}",
+		"{1} 'alle ore' {0}":  "{date} [alle ore] {time}",
+		"{1} 'à' {0}":         "{date} à {time}",
+		"h 'o''clock' a":      "h [o'clock] A",
+		"HH''mm":              "HH'mm",
+		"cccc d LLLL":         "dddd D MMMM",
+	} {
+		actual, err := convertPattern(pattern, ":")
+		assert.NoError(t, err, pattern)
+		assert.Equal(t, expected, actual, pattern)
+	}
+
+	actual, err := convertPattern("HH:mm", ".")
+	assert.NoError(t, err)
+	assert.Equal(t, "HH.mm", actual)
+
+	_, err = convertPattern("'Week of' EEE, MMM d, yyy", ":")
+	assert.EqualError(t, err, `pattern "'Week of' EEE, MMM d, yyy" has the unsupported field yyy`)
+
+	for _, pattern := range []string{"HH 'mm", "{1} {0", "{2} {0}", "GGGG y"} {
+		_, err := convertPattern(pattern, ":")
+		assert.Error(t, err, pattern)
+	}
+}
+
+func TestLoadLocale(t *testing.T) {
+	actual, err := loadLocale("testdata", "de")
+	assert.NoError(t, err)
+	assert.Equal(t, "de", actual.Code)
+	assert.Equal(t, "März", actual.Months[2])
+	assert.Equal(t, "Sept.", actual.MonthsShort[8])
+	assert.Equal(t, []string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"}, actual.Weekdays)
+	assert.Equal(t, "So.", actual.WeekdaysShort[0])
+	assert.Equal(t, []string{"AM", "PM"}, actual.Meridiem)
+	assert.Equal(t, []string{"DD.MM.YY", "DD.MM.YYYY", "D. MMMM YYYY", "dddd, D. MMMM YYYY"}, actual.DateFormats)
+	assert.Equal(t, []string{"HH:mm", "HH:mm:ss", "HH:mm:ss ZZZ", "HH:mm:ss ZZZ"}, actual.TimeFormats)
+	// full and long use the patterns at a time
+	assert.Equal(
+		t,
+		[]string{"{date}, {time}", "{date}, {time}", "{date} [um] {time}", "{date} [um] {time}"},
+		actual.DateTimeFormats,
+	)
+
+	_, err = loadLocale("testdata", "xx")
+	assert.Error(t, err)
+}
+
+func TestTimeSeparatorOf(t *testing.T) {
+	actual, err := timeSeparatorOf(map[string]json.RawMessage{
+		"defaultNumberingSystem":    json.RawMessage(`"latn"`),
+		"symbols-numberSystem-latn": json.RawMessage(`{"timeSeparator": "."}`),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, ".", actual)
+
+	_, err = timeSeparatorOf(map[string]json.RawMessage{"defaultNumberingSystem": json.RawMessage(`"arab"`)})
+	assert.EqualError(t, err, `the default numbering system "arab" is not supported`)
+}
+
+func TestRender(t *testing.T) {
+	actual, err := render([]locale{{Code: "xx", Meridiem: []string{"a.\u00a0m.", "p.\u00a0m."}}})
+	assert.NoError(t, err)
+	assert.Contains(t, string(actual), "// Code generated by go run ./internal/gencldr; DO NOT EDIT.\n")
+	assert.Contains(t, string(actual), "\t\"xx\": {\n")
+	assert.Contains(t, string(actual), `Meridiem:        [2]string{"a.\u00a0m.", "p.\u00a0m."},`)
+}
@@ -0,0 +1,288 @@
+package gostradamus
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Interval is the half-open range of time between Start and End,
+// which includes Start but excludes End.
+// An Interval with equal Start and End is empty.
+type Interval struct {
+	Start DateTime
+	End   DateTime
+}
+
+var (
+	isoDurationRegexp = regexp.MustCompile(
+		`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:[.,]\d{1,9})?)S)?)?$`,
+	)
+
+	isoDateTimeLayouts = []string{
+		time.RFC3339Nano,
+		"2006-01-02T15:04:05.999999999",
+		"2006-01-02T15:04",
+		"2006-01-02",
+	}
+)
+
+// isoDuration is a parsed ISO-8601 duration like P1Y2M3DT4H5M6S
+type isoDuration struct {
+	years  int
+	months int
+	days   int
+	clock  time.Duration
+}
+
+// NewInterval returns a new Interval from start to end
+//
+// NewInterval panics if end is before start
+func NewInterval(start DateTime, end DateTime) Interval {
+	if end.IsBefore(start) {
+		panic(IntervalEndIsBeforeStart(start, end))
+	}
+	return Interval{Start: start, End: end}
+}
+
+// IntervalFromSpan returns the Interval of a span, like the ones returned by SpanDay or Span.
+// Spans include their end, therefore the Interval ends one nanosecond after the span.
+//
+// For Example:
+//
+//	IntervalFromSpan(dt.SpanDay()) becomes [2012-12-12 00:00:00.000000000, 2012-12-13 00:00:00.000000000)
+func IntervalFromSpan(start DateTime, end DateTime) Interval {
+	return NewInterval(start, end.ShiftNanoseconds(1))
+}
+
+// SpanInterval returns the Interval of current span of given unit
+//
+// For Example:
+//
+//	2012-12-12 12:12:12.123456789 with UnitDay becomes [2012-12-12 00:00:00.000000000, 2012-12-13 00:00:00.000000000)
+//
+// SpanInterval panics if the unit is not supported
+func (dt DateTime) SpanInterval(unit Unit) Interval {
+	return IntervalFromSpan(dt.Span(unit))
+}
+
+// ParseInterval parses an ISO-8601 interval into a new Interval.
+// Supported are the forms "start/end", "start/duration" and "duration/end",
+// for example "2012-12-12T00:00:00Z/2012-12-13T00:00:00Z" or "2012-12-12T00:00:00Z/P1D".
+// DateTimes without offset are parsed in UTC.
+func ParseInterval(value string) (Interval, error) {
+	startValue, endValue, found := strings.Cut(value, "/")
+	if !found {
+		return Interval{}, IntervalIsNotParsable(value)
+	}
+
+	var start, end DateTime
+	var err error
+	switch {
+	case strings.HasPrefix(startValue, "P"):
+		end, err = parseIsoDateTime(endValue)
+		if err != nil {
+			return Interval{}, IntervalIsNotParsable(value)
+		}
+		duration, err := parseIsoDuration(startValue)
+		if err != nil {
+			return Interval{}, IntervalIsNotParsable(value)
+		}
+		start = duration.subtractFrom(end)
+	case strings.HasPrefix(endValue, "P"):
+		start, err = parseIsoDateTime(startValue)
+		if err != nil {
+			return Interval{}, IntervalIsNotParsable(value)
+		}
+		duration, err := parseIsoDuration(endValue)
+		if err != nil {
+			return Interval{}, IntervalIsNotParsable(value)
+		}
+		end = duration.addTo(start)
+	default:
+		start, err = parseIsoDateTime(startValue)
+		if err != nil {
+			return Interval{}, IntervalIsNotParsable(value)
+		}
+		end, err = parseIsoDateTime(endValue)
+		if err != nil {
+			return Interval{}, IntervalIsNotParsable(value)
+		}
+	}
+
+	if end.IsBefore(start) {
+		return Interval{}, IntervalEndIsBeforeStart(start, end)
+	}
+	return Interval{Start: start, End: end}, nil
+}
+
+// String returns the Interval as ISO-8601 interval "start/end"
+// Example: "2012-12-12T00:00:00+01:00/2012-12-13T00:00:00+01:00"
+func (i Interval) String() string {
+	return i.Start.Time().Format(time.RFC3339Nano) + "/" + i.End.Time().Format(time.RFC3339Nano)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface,
+// which is used for JSON as well
+func (i Interval) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// which is used for JSON as well. It accepts every form ParseInterval accepts.
+func (i *Interval) UnmarshalText(text []byte) error {
+	interval, err := ParseInterval(string(text))
+	if err != nil {
+		return err
+	}
+	*i = interval
+	return nil
+}
+
+// Duration returns the elapsed time between Start and End
+func (i Interval) Duration() time.Duration {
+	return i.End.Time().Sub(i.Start.Time())
+}
+
+// IsEmpty checks if the Interval contains no instant at all
+func (i Interval) IsEmpty() bool {
+	return !i.Start.IsBefore(i.End)
+}
+
+// Equal checks if both Intervals start and end at the same instants
+func (i Interval) Equal(other Interval) bool {
+	return i.Start.Equal(other.Start) && i.End.Equal(other.End)
+}
+
+// Contains checks if the DateTime is inside the Interval, which includes Start but excludes End
+func (i Interval) Contains(dateTime DateTime) bool {
+	return dateTime.IsBetweenBounds(i.Start, i.End, BoundsClosedOpen)
+}
+
+// ContainsInterval checks if the other Interval is completely inside the Interval
+func (i Interval) ContainsInterval(other Interval) bool {
+	return !other.Start.IsBefore(i.Start) && !other.End.IsAfter(i.End)
+}
+
+// Overlaps checks if both Intervals have at least one instant in common
+func (i Interval) Overlaps(other Interval) bool {
+	return i.Start.IsBefore(other.End) && other.Start.IsBefore(i.End)
+}
+
+// Abuts checks if one Interval ends exactly where the other one starts
+func (i Interval) Abuts(other Interval) bool {
+	return i.End.Equal(other.Start) || other.End.Equal(i.Start)
+}
+
+// Intersection returns the Interval both Intervals have in common
+// The returned bool is false if the Intervals do not overlap
+func (i Interval) Intersection(other Interval) (Interval, bool) {
+	if !i.Overlaps(other) {
+		return Interval{}, false
+	}
+	return Interval{Start: Max(i.Start, other.Start), End: Min(i.End, other.End)}, true
+}
+
+// Union returns the Interval covering both Intervals
+// The returned bool is false if the Intervals neither overlap nor abut,
+// because their union would not be a single Interval
+func (i Interval) Union(other Interval) (Interval, bool) {
+	if !i.Overlaps(other) && !i.Abuts(other) {
+		return Interval{}, false
+	}
+	return Interval{Start: Min(i.Start, other.Start), End: Max(i.End, other.End)}, true
+}
+
+// Gap returns the Interval between both Intervals
+// The returned bool is false if the Intervals overlap or abut
+func (i Interval) Gap(other Interval) (Interval, bool) {
+	switch {
+	case i.End.IsBefore(other.Start):
+		return Interval{Start: i.End, End: other.Start}, true
+	case other.End.IsBefore(i.Start):
+		return Interval{Start: other.End, End: i.Start}, true
+	}
+	return Interval{}, false
+}
+
+// Split returns consecutive Intervals, which are split at every start of given unit
+// The first and the last Interval can be shorter than the unit.
+//
+// For Example:
+//
+//	[2012-12-12 10:30, 2012-12-12 12:15) split by UnitHour becomes
+//	[10:30, 11:00), [11:00, 12:00), [12:00, 12:15)
+//
+// Split panics if the unit is not supported
+func (i Interval) Split(unit Unit) []Interval {
+	var intervals []Interval
+	start := i.Start
+	for start.IsBefore(i.End) {
+		end := Min(nextStartOf(start, unit), i.End)
+		intervals = append(intervals, Interval{Start: start, End: end})
+		start = end
+	}
+	return intervals
+}
+
+// nextStartOf returns the start of the unit following the one of dt.
+// The start is computed on the wall clock in the location of dt, which keeps offsets without a Timezone name,
+// like the ones of ParseInterval. It is always after dt, even if the wall clock repeats at the end of DST.
+func nextStartOf(dt DateTime, unit Unit) DateTime {
+	t := dt.Time()
+	wall := NewUTCDateTime(t.Year(), int(t.Month()), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
+	next := wall.Ceil(unit).ShiftNanoseconds(1).Time()
+	nextStart := DateTimeFromTime(time.Date(
+		next.Year(), next.Month(), next.Day(), next.Hour(), next.Minute(), next.Second(), next.Nanosecond(), t.Location(),
+	))
+	if !nextStart.IsAfter(dt) {
+		return dt.ShiftBy(unit, 1)
+	}
+	return nextStart
+}
+
+func parseIsoDateTime(value string) (DateTime, error) {
+	var err error
+	for _, layout := range isoDateTimeLayouts {
+		var parsedTime time.Time
+		parsedTime, err = time.Parse(layout, value)
+		if err == nil {
+			return DateTimeFromTime(parsedTime), nil
+		}
+	}
+	return DateTime{}, err
+}
+
+func parseIsoDuration(value string) (isoDuration, error) {
+	matches := isoDurationRegexp.FindStringSubmatch(value)
+	if matches == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return isoDuration{}, IsoDurationIsNotParsable(value)
+	}
+
+	number := func(index int) int {
+		parsedNumber, _ := strconv.Atoi(matches[index])
+		return parsedNumber
+	}
+	seconds, fraction, _ := strings.Cut(strings.Replace(matches[7], ",", ".", 1), ".")
+	parsedSeconds, _ := strconv.Atoi(seconds)
+	nanoseconds, _ := strconv.Atoi((fraction + "000000000")[:9])
+
+	return isoDuration{
+		years:  number(1),
+		months: number(2),
+		days:   number(3)*WeekInDays + number(4),
+		clock: time.Duration(number(5))*time.Hour +
+			time.Duration(number(6))*time.Minute +
+			time.Duration(parsedSeconds)*time.Second +
+			time.Duration(nanoseconds),
+	}, nil
+}
+
+func (d isoDuration) addTo(dateTime DateTime) DateTime {
+	return DateTimeFromTime(dateTime.Time().AddDate(d.years, d.months, d.days).Add(d.clock))
+}
+
+func (d isoDuration) subtractFrom(dateTime DateTime) DateTime {
+	return DateTimeFromTime(dateTime.Time().Add(-d.clock).AddDate(-d.years, -d.months, -d.days))
+}
@@ -0,0 +1,157 @@
+package gostradamus
+
+import (
+	"slices"
+	"sort"
+	"time"
+)
+
+// IntervalSet is a set of instants, which is kept as sorted Intervals,
+// that neither overlap nor abut and are never empty.
+// The zero value is an empty IntervalSet. An IntervalSet is never modified in place,
+// all operations return a new IntervalSet.
+type IntervalSet struct {
+	intervals []Interval
+}
+
+// NewIntervalSet returns a new IntervalSet of given Intervals.
+// Overlapping and abutting Intervals are merged, empty Intervals are dropped.
+func NewIntervalSet(intervals ...Interval) IntervalSet {
+	normalized := make([]Interval, 0, len(intervals))
+	for _, interval := range intervals {
+		if !interval.IsEmpty() {
+			normalized = append(normalized, interval)
+		}
+	}
+	slices.SortFunc(normalized, func(a Interval, b Interval) int {
+		return a.Start.Compare(b.Start)
+	})
+
+	merged := normalized[:0]
+	for _, interval := range normalized {
+		last := len(merged) - 1
+		if last >= 0 && !merged[last].End.IsBefore(interval.Start) {
+			merged[last].End = Max(merged[last].End, interval.End)
+			continue
+		}
+		merged = append(merged, interval)
+	}
+	return IntervalSet{intervals: merged}
+}
+
+// Intervals returns a copy of the sorted Intervals of the IntervalSet
+func (s IntervalSet) Intervals() []Interval {
+	return slices.Clone(s.intervals)
+}
+
+// IsEmpty checks if the IntervalSet contains no instant at all
+func (s IntervalSet) IsEmpty() bool {
+	return len(s.intervals) == 0
+}
+
+// Duration returns the sum of the durations of all Intervals
+func (s IntervalSet) Duration() time.Duration {
+	var duration time.Duration
+	for _, interval := range s.intervals {
+		duration += interval.Duration()
+	}
+	return duration
+}
+
+// Equal checks if both IntervalSets contain the same instants
+func (s IntervalSet) Equal(other IntervalSet) bool {
+	return slices.EqualFunc(s.intervals, other.intervals, Interval.Equal)
+}
+
+// Add returns a new IntervalSet, which additionally contains the given Intervals
+func (s IntervalSet) Add(intervals ...Interval) IntervalSet {
+	return NewIntervalSet(append(slices.Clone(s.intervals), intervals...)...)
+}
+
+// Union returns a new IntervalSet with all instants, which are in one of both IntervalSets
+func (s IntervalSet) Union(other IntervalSet) IntervalSet {
+	return s.Add(other.intervals...)
+}
+
+// Intersection returns a new IntervalSet with all instants, which are in both IntervalSets
+func (s IntervalSet) Intersection(other IntervalSet) IntervalSet {
+	var intersections []Interval
+	i, j := 0, 0
+	for i < len(s.intervals) && j < len(other.intervals) {
+		if intersection, ok := s.intervals[i].Intersection(other.intervals[j]); ok {
+			intersections = append(intersections, intersection)
+		}
+		if s.intervals[i].End.IsBefore(other.intervals[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return NewIntervalSet(intersections...)
+}
+
+// Difference returns a new IntervalSet with all instants of the IntervalSet, which are not in the other IntervalSet
+func (s IntervalSet) Difference(other IntervalSet) IntervalSet {
+	var differences []Interval
+	j := 0
+	for _, current := range s.intervals {
+		// skip all Intervals, which end before the current Interval starts
+		for j < len(other.intervals) && !other.intervals[j].End.IsAfter(current.Start) {
+			j++
+		}
+
+		start := current.Start
+		for k := j; k < len(other.intervals) && other.intervals[k].Start.IsBefore(current.End); k++ {
+			if other.intervals[k].Start.IsAfter(start) {
+				differences = append(differences, Interval{Start: start, End: other.intervals[k].Start})
+			}
+			start = Max(start, other.intervals[k].End)
+		}
+		if start.IsBefore(current.End) {
+			differences = append(differences, Interval{Start: start, End: current.End})
+		}
+	}
+	return NewIntervalSet(differences...)
+}
+
+// Complement returns a new IntervalSet with all instants inside of bound, which are not in the IntervalSet
+//
+// For Example:
+//
+//	the complement of the busy blocks of a day within the working hours are the free slots
+func (s IntervalSet) Complement(bound Interval) IntervalSet {
+	return NewIntervalSet(bound).Difference(s)
+}
+
+// Contains checks if the DateTime is in the IntervalSet
+func (s IntervalSet) Contains(dateTime DateTime) bool {
+	index := s.search(dateTime)
+	return index < len(s.intervals) && s.intervals[index].Contains(dateTime)
+}
+
+// ContainsInterval checks if all instants of the Interval are in the IntervalSet
+// Empty Intervals are always contained
+func (s IntervalSet) ContainsInterval(interval Interval) bool {
+	if interval.IsEmpty() {
+		return true
+	}
+	index := s.search(interval.Start)
+	return index < len(s.intervals) && s.intervals[index].ContainsInterval(interval)
+}
+
+// Overlaps checks if the IntervalSet and the Interval have at least one instant in common
+// Empty Intervals never overlap
+func (s IntervalSet) Overlaps(interval Interval) bool {
+	if interval.IsEmpty() {
+		return false
+	}
+	index := s.search(interval.Start)
+	return index < len(s.intervals) && s.intervals[index].Overlaps(interval)
+}
+
+// search returns the index of the first Interval, which ends after the DateTime
+func (s IntervalSet) search(dateTime DateTime) int {
+	return sort.Search(len(s.intervals), func(index int) bool {
+		return s.intervals[index].End.IsAfter(dateTime)
+	})
+}
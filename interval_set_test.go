@@ -0,0 +1,201 @@
+package gostradamus
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// minutes returns an Interval between the given minutes of 2020-01-01 in UTC
+func minutes(start int, end int) Interval {
+	origin := NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0)
+	return NewInterval(origin.ShiftMinutes(start), origin.ShiftMinutes(end))
+}
+
+// intervalsFromBytes builds Intervals within the first 256 minutes of 2020-01-01 from pairs of bytes
+func intervalsFromBytes(values []byte) []Interval {
+	var intervals []Interval
+	for i := 0; i+1 < len(values); i += 2 {
+		start, end := int(values[i]), int(values[i+1])
+		if end < start {
+			start, end = end, start
+		}
+		intervals = append(intervals, minutes(start, end))
+	}
+	return intervals
+}
+
+// containsNaive checks by brute force if any of the Intervals contains the DateTime
+func containsNaive(intervals []Interval, dateTime DateTime) bool {
+	for _, interval := range intervals {
+		if interval.Contains(dateTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// isNormalized checks if the Intervals are sorted and neither empty, overlapping nor abutting
+func isNormalized(set IntervalSet) bool {
+	intervals := set.Intervals()
+	for i, interval := range intervals {
+		if interval.IsEmpty() {
+			return false
+		}
+		if i > 0 && !intervals[i-1].End.IsBefore(interval.Start) {
+			return false
+		}
+	}
+	return true
+}
+
+// forEachSample calls check for every half minute of the first 257 minutes of 2020-01-01
+func forEachSample(check func(dateTime DateTime) bool) bool {
+	origin := NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0)
+	for i := 0; i <= 257*2; i++ {
+		if !check(DateTimeFromTime(origin.Time().Add(time.Duration(i) * 30 * time.Second))) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestNewIntervalSet(t *testing.T) {
+	actual := NewIntervalSet(
+		minutes(30, 40),
+		minutes(0, 10),
+		minutes(5, 15),
+		minutes(15, 20),
+		minutes(50, 50),
+	)
+	assert.Equal(t, []Interval{minutes(0, 20), minutes(30, 40)}, actual.Intervals())
+	assert.Equal(t, 30*time.Minute, actual.Duration())
+
+	assert.True(t, IntervalSet{}.IsEmpty())
+	assert.True(t, NewIntervalSet(minutes(50, 50)).IsEmpty())
+}
+
+func TestIntervalSet_Properties(t *testing.T) {
+	config := &quick.Config{MaxCount: 1000, Rand: rand.New(rand.NewSource(1))}
+
+	normalized := func(values []byte) bool {
+		intervals := intervalsFromBytes(values)
+		set := NewIntervalSet(intervals...)
+		return isNormalized(set) && forEachSample(func(dateTime DateTime) bool {
+			return set.Contains(dateTime) == containsNaive(intervals, dateTime)
+		})
+	}
+	assert.NoError(t, quick.Check(normalized, config))
+
+	union := func(a []byte, b []byte) bool {
+		first, second := intervalsFromBytes(a), intervalsFromBytes(b)
+		set := NewIntervalSet(first...).Union(NewIntervalSet(second...))
+		return isNormalized(set) && forEachSample(func(dateTime DateTime) bool {
+			return set.Contains(dateTime) == (containsNaive(first, dateTime) || containsNaive(second, dateTime))
+		})
+	}
+	assert.NoError(t, quick.Check(union, config))
+
+	intersection := func(a []byte, b []byte) bool {
+		first, second := intervalsFromBytes(a), intervalsFromBytes(b)
+		set := NewIntervalSet(first...).Intersection(NewIntervalSet(second...))
+		return isNormalized(set) && forEachSample(func(dateTime DateTime) bool {
+			return set.Contains(dateTime) == (containsNaive(first, dateTime) && containsNaive(second, dateTime))
+		})
+	}
+	assert.NoError(t, quick.Check(intersection, config))
+
+	difference := func(a []byte, b []byte) bool {
+		first, second := intervalsFromBytes(a), intervalsFromBytes(b)
+		set := NewIntervalSet(first...).Difference(NewIntervalSet(second...))
+		return isNormalized(set) && forEachSample(func(dateTime DateTime) bool {
+			return set.Contains(dateTime) == (containsNaive(first, dateTime) && !containsNaive(second, dateTime))
+		})
+	}
+	assert.NoError(t, quick.Check(difference, config))
+
+	complement := func(a []byte, boundStart uint8, boundEnd uint8) bool {
+		intervals := intervalsFromBytes(a)
+		bound := intervalsFromBytes([]byte{boundStart, boundEnd})[0]
+		set := NewIntervalSet(intervals...).Complement(bound)
+		return isNormalized(set) && forEachSample(func(dateTime DateTime) bool {
+			return set.Contains(dateTime) == (bound.Contains(dateTime) && !containsNaive(intervals, dateTime))
+		})
+	}
+	assert.NoError(t, quick.Check(complement, config))
+
+	containsInterval := func(a []byte, start uint8, end uint8) bool {
+		intervals := intervalsFromBytes(a)
+		interval := intervalsFromBytes([]byte{start, end})[0]
+		set := NewIntervalSet(intervals...)
+		expected := set.Intersection(NewIntervalSet(interval)).Equal(NewIntervalSet(interval))
+		return set.ContainsInterval(interval) == expected
+	}
+	assert.NoError(t, quick.Check(containsInterval, config))
+
+	overlaps := func(a []byte, start uint8, end uint8) bool {
+		intervals := intervalsFromBytes(a)
+		interval := intervalsFromBytes([]byte{start, end})[0]
+		set := NewIntervalSet(intervals...)
+		return set.Overlaps(interval) == !set.Intersection(NewIntervalSet(interval)).IsEmpty()
+	}
+	assert.NoError(t, quick.Check(overlaps, config))
+}
+
+func TestIntervalSet_Add(t *testing.T) {
+	set := NewIntervalSet(minutes(0, 10))
+	actual := set.Add(minutes(10, 20), minutes(30, 40))
+	assert.Equal(t, []Interval{minutes(0, 20), minutes(30, 40)}, actual.Intervals())
+
+	// The original IntervalSet is not modified
+	assert.Equal(t, []Interval{minutes(0, 10)}, set.Intervals())
+}
+
+func TestIntervalSet_Difference(t *testing.T) {
+	workingHours := NewIntervalSet(minutes(9*60, 17*60))
+	busy := NewIntervalSet(minutes(10*60, 11*60), minutes(12*60, 13*60), minutes(16*60, 18*60))
+	actual := workingHours.Difference(busy)
+	assert.Equal(
+		t,
+		[]Interval{
+			minutes(9*60, 10*60),
+			minutes(11*60, 12*60),
+			minutes(13*60, 16*60),
+		},
+		actual.Intervals(),
+	)
+}
+
+func TestIntervalSet_Complement(t *testing.T) {
+	busy := NewIntervalSet(minutes(10*60, 11*60), minutes(16*60, 18*60))
+	actual := busy.Complement(minutes(9*60, 17*60))
+	assert.Equal(t, []Interval{minutes(9*60, 10*60), minutes(11*60, 16*60)}, actual.Intervals())
+}
+
+func TestIntervalSet_Contains(t *testing.T) {
+	set := NewIntervalSet(minutes(0, 10), minutes(20, 30))
+	origin := NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0)
+	assert.True(t, set.Contains(origin))
+	assert.False(t, set.Contains(origin.ShiftMinutes(10)))
+	assert.True(t, set.Contains(origin.ShiftMinutes(25)))
+	assert.False(t, set.Contains(origin.ShiftMinutes(30)))
+	assert.False(t, IntervalSet{}.Contains(origin))
+}
+
+func TestIntervalSet_ContainsInterval(t *testing.T) {
+	set := NewIntervalSet(minutes(0, 10), minutes(20, 30))
+	assert.True(t, set.ContainsInterval(minutes(2, 8)))
+	assert.True(t, set.ContainsInterval(minutes(20, 30)))
+	assert.False(t, set.ContainsInterval(minutes(5, 25)))
+	assert.True(t, set.ContainsInterval(minutes(15, 15)))
+}
+
+func TestIntervalSet_Overlaps(t *testing.T) {
+	set := NewIntervalSet(minutes(0, 10), minutes(20, 30))
+	assert.True(t, set.Overlaps(minutes(5, 25)))
+	assert.False(t, set.Overlaps(minutes(10, 20)))
+	assert.False(t, set.Overlaps(minutes(5, 5)))
+}
@@ -0,0 +1,242 @@
+package gostradamus
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInterval(t *testing.T) {
+	start := NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0)
+	end := NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0)
+	assert.Equal(t, Interval{Start: start, End: end}, NewInterval(start, end))
+
+	assert.PanicsWithError(
+		t,
+		"Interval: end 2020-01-01T00:00:00.000000Z is before start 2020-01-02T00:00:00.000000Z",
+		func() {
+			NewInterval(end, start)
+		},
+	)
+}
+
+func TestIntervalFromSpan(t *testing.T) {
+	actual := IntervalFromSpan(NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0).SpanDay())
+	assert.Equal(
+		t,
+		NewInterval(NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0), NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0)),
+		actual,
+	)
+}
+
+func TestDateTime_SpanInterval(t *testing.T) {
+	actual := NewUTCDateTime(2020, 2, 12, 12, 0, 0, 0).SpanInterval(UnitMonth)
+	assert.Equal(
+		t,
+		NewInterval(NewUTCDateTime(2020, 2, 1, 0, 0, 0, 0), NewUTCDateTime(2020, 3, 1, 0, 0, 0, 0)),
+		actual,
+	)
+	assert.Equal(t, 29*24*time.Hour, actual.Duration())
+}
+
+func TestParseInterval(t *testing.T) {
+	expected := NewInterval(NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0), NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0))
+
+	actual, err := ParseInterval("2020-01-01T00:00:00Z/2020-01-02T00:00:00Z")
+	assert.NoError(t, err)
+	assert.True(t, expected.Equal(actual))
+
+	actual, err = ParseInterval("2020-01-01T00:00:00Z/P1D")
+	assert.NoError(t, err)
+	assert.True(t, expected.Equal(actual))
+
+	actual, err = ParseInterval("P1D/2020-01-02T00:00:00Z")
+	assert.NoError(t, err)
+	assert.True(t, expected.Equal(actual))
+
+	actual, err = ParseInterval("2020-01-01/2020-01-02")
+	assert.NoError(t, err)
+	assert.True(t, expected.Equal(actual))
+
+	actual, err = ParseInterval("2020-01-31T00:00:00+01:00/P1Y1M1W1DT1H1M1.5S")
+	assert.NoError(t, err)
+	assert.Equal(t, "2020-01-31T00:00:00+01:00/2021-03-11T01:01:01.5+01:00", actual.String())
+
+	actual, err = ParseInterval("2020-01-01T00:00:00Z/PT0,25S")
+	assert.NoError(t, err)
+	assert.Equal(t, 250*time.Millisecond, actual.Duration())
+
+	for _, value := range []string{
+		"2020-01-01T00:00:00Z",
+		"2020-01-01T00:00:00Z/P",
+		"2020-01-01T00:00:00Z/PT",
+		"2020-01-01T00:00:00Z/P1X",
+		"P1D/P1D",
+		"yesterday/2020-01-01T00:00:00Z",
+		"2020-01-01T00:00:00Z/tomorrow",
+	} {
+		_, err = ParseInterval(value)
+		assert.EqualError(t, err, "Interval: "+value+" is not parsable", value)
+	}
+
+	_, err = ParseInterval("2020-01-02T00:00:00Z/2020-01-01T00:00:00Z")
+	assert.Error(t, err)
+}
+
+func TestInterval_String(t *testing.T) {
+	actual := NewInterval(
+		NewDateTime(2020, 1, 1, 0, 0, 0, 0, EuropeBerlin),
+		NewDateTime(2020, 1, 1, 12, 30, 0, 500, EuropeBerlin),
+	).String()
+	assert.Equal(t, "2020-01-01T00:00:00+01:00/2020-01-01T12:30:00.0000005+01:00", actual)
+}
+
+func TestInterval_JSON(t *testing.T) {
+	interval := NewInterval(NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0), NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0))
+
+	actual, err := json.Marshal(map[string]Interval{"interval": interval})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"interval":"2020-01-01T00:00:00Z/2020-01-02T00:00:00Z"}`, string(actual))
+
+	var decoded map[string]Interval
+	assert.NoError(t, json.Unmarshal([]byte(`{"interval":"2020-01-01T00:00:00Z/P1D"}`), &decoded))
+	assert.True(t, interval.Equal(decoded["interval"]))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"interval":"2020-01-01T00:00:00Z"}`), &decoded))
+}
+
+func TestInterval_IsEmpty(t *testing.T) {
+	dateTime := NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0)
+	assert.True(t, NewInterval(dateTime, dateTime).IsEmpty())
+	assert.False(t, NewInterval(dateTime, dateTime.ShiftNanoseconds(1)).IsEmpty())
+}
+
+func TestInterval_Contains(t *testing.T) {
+	interval := NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0).SpanInterval(UnitDay)
+	assert.True(t, interval.Contains(NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0)))
+	assert.True(t, interval.Contains(NewUTCDateTime(2020, 1, 1, 23, 59, 59, 999999999)))
+	assert.False(t, interval.Contains(NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0)))
+	assert.False(t, interval.Contains(NewUTCDateTime(2019, 12, 31, 23, 59, 59, 999999999)))
+}
+
+func TestInterval_ContainsInterval(t *testing.T) {
+	interval := NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0).SpanInterval(UnitDay)
+	assert.True(t, interval.ContainsInterval(interval))
+	assert.True(t, interval.ContainsInterval(NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0).SpanInterval(UnitHour)))
+	assert.False(t, interval.ContainsInterval(NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0).SpanInterval(UnitWeek)))
+}
+
+func TestInterval_Overlaps(t *testing.T) {
+	first := NewInterval(NewUTCDateTime(2020, 1, 1, 10, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0))
+	second := NewInterval(NewUTCDateTime(2020, 1, 1, 11, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 13, 0, 0, 0))
+	third := NewInterval(NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 13, 0, 0, 0))
+
+	assert.True(t, first.Overlaps(second))
+	assert.True(t, second.Overlaps(first))
+	assert.False(t, first.Overlaps(third))
+	assert.False(t, third.Overlaps(first))
+}
+
+func TestInterval_Abuts(t *testing.T) {
+	first := NewInterval(NewUTCDateTime(2020, 1, 1, 10, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0))
+	second := NewInterval(NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 13, 0, 0, 0))
+	third := NewInterval(NewUTCDateTime(2020, 1, 1, 11, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 13, 0, 0, 0))
+
+	assert.True(t, first.Abuts(second))
+	assert.True(t, second.Abuts(first))
+	assert.False(t, first.Abuts(third))
+}
+
+func TestInterval_Intersection(t *testing.T) {
+	first := NewInterval(NewUTCDateTime(2020, 1, 1, 10, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0))
+	second := NewInterval(NewUTCDateTime(2020, 1, 1, 11, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 13, 0, 0, 0))
+	third := NewInterval(NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 13, 0, 0, 0))
+
+	actual, ok := first.Intersection(second)
+	assert.True(t, ok)
+	assert.Equal(t, NewInterval(NewUTCDateTime(2020, 1, 1, 11, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0)), actual)
+
+	_, ok = first.Intersection(third)
+	assert.False(t, ok)
+}
+
+func TestInterval_Union(t *testing.T) {
+	first := NewInterval(NewUTCDateTime(2020, 1, 1, 10, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0))
+	second := NewInterval(NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 13, 0, 0, 0))
+	third := NewInterval(NewUTCDateTime(2020, 1, 1, 14, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 15, 0, 0, 0))
+
+	actual, ok := first.Union(second)
+	assert.True(t, ok)
+	assert.Equal(t, NewInterval(NewUTCDateTime(2020, 1, 1, 10, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 13, 0, 0, 0)), actual)
+
+	_, ok = first.Union(third)
+	assert.False(t, ok)
+}
+
+func TestInterval_Gap(t *testing.T) {
+	first := NewInterval(NewUTCDateTime(2020, 1, 1, 10, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0))
+	second := NewInterval(NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 13, 0, 0, 0))
+	third := NewInterval(NewUTCDateTime(2020, 1, 1, 14, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 15, 0, 0, 0))
+
+	actual, ok := first.Gap(third)
+	assert.True(t, ok)
+	assert.Equal(t, NewInterval(NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 14, 0, 0, 0)), actual)
+
+	actual, ok = third.Gap(first)
+	assert.True(t, ok)
+	assert.Equal(t, NewInterval(NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 14, 0, 0, 0)), actual)
+
+	_, ok = first.Gap(second)
+	assert.False(t, ok)
+}
+
+func TestInterval_Split(t *testing.T) {
+	actual := NewInterval(
+		NewUTCDateTime(2020, 1, 1, 10, 30, 0, 0),
+		NewUTCDateTime(2020, 1, 1, 12, 15, 0, 0),
+	).Split(UnitHour)
+	assert.Equal(
+		t,
+		[]Interval{
+			NewInterval(NewUTCDateTime(2020, 1, 1, 10, 30, 0, 0), NewUTCDateTime(2020, 1, 1, 11, 0, 0, 0)),
+			NewInterval(NewUTCDateTime(2020, 1, 1, 11, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0)),
+			NewInterval(NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 12, 15, 0, 0)),
+		},
+		actual,
+	)
+
+	actual = NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0).SpanInterval(UnitYear).Split(UnitQuarter)
+	assert.Len(t, actual, 4)
+	assert.Equal(t, NewUTCDateTime(2020, 4, 1, 0, 0, 0, 0), actual[1].Start)
+
+	dateTime := NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0)
+	assert.Empty(t, NewInterval(dateTime, dateTime).Split(UnitDay))
+}
+
+func TestInterval_Split_Offset(t *testing.T) {
+	interval, err := ParseInterval("2012-12-12T23:30:00-05:00/PT2H")
+	assert.NoError(t, err)
+
+	actual := interval.Split(UnitDay)
+	assert.Len(t, actual, 2)
+	midnight := time.Date(2012, 12, 13, 0, 0, 0, 0, time.FixedZone("", -5*60*60))
+	assert.True(t, interval.Start.Time().Equal(actual[0].Start.Time()))
+	assert.True(t, midnight.Equal(actual[0].End.Time()))
+	assert.True(t, midnight.Equal(actual[1].Start.Time()))
+	assert.True(t, interval.End.Time().Equal(actual[1].End.Time()))
+	assert.Equal(t, "2012-12-13T00:00:00.000000-0500", actual[0].End.String())
+
+	// the wall clock from 02:00 to 03:00 repeats at the end of daylight saving time
+	interval = NewInterval(
+		NewUTCDateTime(2020, 10, 25, 0, 30, 0, 0).InTimezone(EuropeBerlin),
+		NewUTCDateTime(2020, 10, 25, 2, 30, 0, 0).InTimezone(EuropeBerlin),
+	)
+	actual = interval.Split(UnitHour)
+	assert.NotEmpty(t, actual)
+	for _, piece := range actual {
+		assert.True(t, piece.End.IsAfter(piece.Start), piece.String())
+	}
+	assert.Equal(t, interval.End, actual[len(actual)-1].End)
+}
@@ -0,0 +1,807 @@
+package gostradamus
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// layoutElement is either a FormatToken or a literal of a format
+type layoutElement struct {
+	token   FormatToken
+	literal string
+}
+
+// parsedFields are the fields read from a value, -1 marks fields, which were not read
+type parsedFields struct {
+	year       int
+	month      int
+	day        int
+	yearDay    int
+	hour       int
+	minute     int
+	second     int
+	nanosecond int
+	meridiem   int
+	weekday    int
+	offset     int
+	hasOffset  bool
+	// isUTC is set if the offset was "Z"
+	isUTC    bool
+	zoneName string
+	// week is the week of year counted by weekRule, isoYear the year of an ISO week
+	week     int
+	weekRule weekRule
+	isoYear  int
+	// present are the Fields, which were read
+	present Field
+}
+
+// weekRule is the rule, which counts the weeks of a year
+type weekRule int
+
+const (
+	// weekRuleIso counts ISO weeks, like GGGG-WW and strftime %G-%V
+	weekRuleIso weekRule = iota
+	// weekRuleSunday counts weeks starting on sunday, days before the first sunday are in week 0, like strftime %U
+	weekRuleSunday
+	// weekRuleMonday counts weeks starting on monday, days before the first monday are in week 0, like strftime %W
+	weekRuleMonday
+)
+
+// strptime directives without an equivalent FormatToken are read with these internal FormatTokens,
+// which can not be part of a format
+const (
+	strptimeSundayWeek FormatToken = "%U"
+	strptimeMondayWeek FormatToken = "%W"
+	strptimeIsoWeekday FormatToken = "%u"
+	strptimeWeekday    FormatToken = "%w"
+	// strptimeDay is the day of month padded with a space
+	strptimeDay FormatToken = "%e"
+)
+
+// parsableFormatTokens are the FormatTokens, which can be read from a value
+var parsableFormatTokens = map[FormatToken]bool{
+	YearFull:                 true,
+	YearShort:                true,
+	MonthFull:                true,
+	MonthAbbr:                true,
+	MonthZeroPadded:          true,
+	MonthShort:               true,
+	DayOfYearZeroPadded:      true,
+	DayOfMonthZeroPadded:     true,
+	DayOfMonthShort:          true,
+	DayOfMonthOrdinal:        true,
+	DayOfWeekFullName:        true,
+	DayOfWeekAbbr:            true,
+	IsoWeekYear:              true,
+	IsoWeekOfYearZeroPadded:  true,
+	IsoWeekOfYear:            true,
+	TwentyFourHourZeroPadded: true,
+	TwentyFourHour:           true,
+	TwelveHourZeroPadded:     true,
+	TwelveHour:               true,
+	AMPMUpper:                true,
+	AMPMLower:                true,
+	MinuteZeroPadded:         true,
+	Minute:                   true,
+	SecondZeroPadded:         true,
+	Second:                   true,
+	MicroSecond:              true,
+	TimezoneFullName:         true,
+	TimezoneWithColon:        true,
+	TimezoneWithoutColon:     true,
+	strptimeSundayWeek:       true,
+	strptimeMondayWeek:       true,
+	strptimeIsoWeekday:       true,
+	strptimeWeekday:          true,
+	strptimeDay:              true,
+}
+
+// tokenFields are the Fields, which are present if a FormatToken was read
+var tokenFields = map[FormatToken]Field{
+	YearFull:                 FieldYear,
+	YearShort:                FieldYear,
+	MonthFull:                FieldMonth,
+	MonthAbbr:                FieldMonth,
+	MonthZeroPadded:          FieldMonth,
+	MonthShort:               FieldMonth,
+	DayOfYearZeroPadded:      FieldMonth | FieldDay,
+	DayOfMonthZeroPadded:     FieldDay,
+	DayOfMonthShort:          FieldDay,
+	DayOfMonthOrdinal:        FieldDay,
+	DayOfWeekFullName:        FieldWeekday,
+	DayOfWeekAbbr:            FieldWeekday,
+	IsoWeekYear:              FieldYear,
+	IsoWeekOfYearZeroPadded:  FieldMonth | FieldDay,
+	IsoWeekOfYear:            FieldMonth | FieldDay,
+	TwentyFourHourZeroPadded: FieldHour,
+	TwentyFourHour:           FieldHour,
+	TwelveHourZeroPadded:     FieldHour,
+	TwelveHour:               FieldHour,
+	MinuteZeroPadded:         FieldMinute,
+	Minute:                   FieldMinute,
+	SecondZeroPadded:         FieldSecond,
+	Second:                   FieldSecond,
+	MicroSecond:              FieldNanosecond,
+	TimezoneFullName:         FieldTimezone,
+	TimezoneWithColon:        FieldTimezone,
+	TimezoneWithoutColon:     FieldTimezone,
+	strptimeSundayWeek:       FieldMonth | FieldDay,
+	strptimeMondayWeek:       FieldMonth | FieldDay,
+	strptimeIsoWeekday:       FieldWeekday,
+	strptimeWeekday:          FieldWeekday,
+	strptimeDay:              FieldDay,
+}
+
+// significantFields are the Fields of a date and time from the most to the least significant
+var significantFields = []Field{FieldYear, FieldMonth, FieldDay, FieldHour, FieldMinute, FieldSecond, FieldNanosecond}
+
+// compileLayout splits format into its FormatTokens and the literals between them.
+// Text in square brackets is a literal and localized FormatTokens are replaced by the formats of given Locale.
+func compileLayout(format string, locale Locale) []layoutElement {
+	return compileLayoutElements(format, locale, true)
+}
+
+func compileLayoutElements(format string, locale Locale, localize bool) []layoutElement {
+	var elements []layoutElement
+	literal := func(value string) {
+		if value == "" {
+			return
+		}
+		// merge neighbouring literals, so spaces in them are matched together while parsing
+		if last := len(elements) - 1; last >= 0 && elements[last].token == "" {
+			elements[last].literal += value
+			return
+		}
+		elements = append(elements, layoutElement{literal: value})
+	}
+
+	last := 0
+	for _, match := range layoutRegexp.FindAllStringIndex(format, -1) {
+		literal(format[last:match[0]])
+		last = match[1]
+
+		value := format[match[0]:match[1]]
+		if strings.HasPrefix(value, "[") {
+			literal(value[1 : len(value)-1])
+			continue
+		}
+		token := FormatToken(value)
+		if localizedFormat, ok := locale.localizedFormat(token); ok && localize {
+			for _, element := range compileLayoutElements(localizedFormat, locale, false) {
+				if element.token == "" {
+					literal(element.literal)
+				} else {
+					elements = append(elements, element)
+				}
+			}
+			continue
+		}
+		elements = append(elements, layoutElement{token: token})
+	}
+	literal(format[last:])
+	return elements
+}
+
+// compiledFormat is a parse format with its compiled layout
+type compiledFormat struct {
+	format   string
+	elements []layoutElement
+}
+
+// parseToTimeInLocale parses the value with given format and the names of given Locale to a time.Time.
+// Fields missing in format are zero or, if zero is impossible, one.
+// error if the value could not be parsed
+func parseToTimeInLocale(value string, format string, timezone Timezone, locale Locale) (time.Time, error) {
+	compiled, err := compileFormat(format, locale)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return compiled.parse(value, timezone.Location(), locale, ParseModeDefault)
+}
+
+// compileFormat compiles the layout of given format to parse values.
+// error if the format contains a FormatToken, which is not parsable
+func compileFormat(format string, locale Locale) (compiledFormat, error) {
+	elements := compileLayout(format, locale)
+	for _, element := range elements {
+		if element.token != "" && !parsableFormatTokens[element.token] {
+			return compiledFormat{}, FormatTokenIsNotParsable(string(element.token))
+		}
+	}
+	return compiledFormat{format: format, elements: elements}, nil
+}
+
+// parse the value with the compiled layout to a time.Time in given location
+func (c compiledFormat) parse(value string, location *time.Location, locale Locale, mode ParseMode) (time.Time, error) {
+	t, _, err := c.parsePartial(value, location, locale, mode, nil)
+	return t, err
+}
+
+// parsePartial parses the value with the compiled layout to a time.Time in given location
+// and returns the Fields, which were present in value.
+// The missing fields are filled from reference, if it is not nil.
+func (c compiledFormat) parsePartial(
+	value string,
+	location *time.Location,
+	locale Locale,
+	mode ParseMode,
+	reference *time.Time,
+) (time.Time, Field, error) {
+	format := c.format
+	fields := parsedFields{month: -1, day: -1, yearDay: -1, meridiem: -1, weekday: -1, week: -1, isoYear: -1}
+	rest := value
+	if mode == ParseModeLenient {
+		rest = strings.TrimSpace(value)
+	}
+	hasFraction := slices.ContainsFunc(c.elements, func(element layoutElement) bool {
+		return element.token == MicroSecond
+	})
+	for _, element := range c.elements {
+		var ok bool
+		if element.token == "" {
+			rest, ok = skipLiteral(rest, element.literal, mode)
+			if !ok {
+				return time.Time{}, 0, ValueIsNotParsable(value, format, fmt.Sprintf("%q does not match %q", rest, element.literal))
+			}
+			continue
+		}
+		rest, ok = fields.parseToken(rest, element.token, locale, mode)
+		if !ok {
+			return time.Time{}, 0, ValueIsNotParsable(value, format, fmt.Sprintf("%q does not match %s", rest, element.token))
+		}
+		// like time.Parse, a fractional second may follow the seconds, if the format has none
+		isSecond := element.token == SecondZeroPadded || element.token == Second
+		if isSecond && !hasFraction && mode != ParseModeStrict {
+			rest = fields.parseFraction(rest)
+		}
+	}
+	if rest != "" {
+		return time.Time{}, 0, ValueIsNotParsable(value, format, fmt.Sprintf("%q is left over", rest))
+	}
+
+	if reference != nil {
+		fields.fill(reference.In(location))
+	}
+	t, reason := fields.toTime(location)
+	if reason != "" {
+		return time.Time{}, 0, ValueIsNotParsable(value, format, reason)
+	}
+	if mode == ParseModeStrict && fields.weekday >= 0 && t.Weekday() != time.Weekday(fields.weekday) {
+		reason = fmt.Sprintf("weekday %s does not match %s", time.Weekday(fields.weekday), t.Format(time.DateOnly))
+		return time.Time{}, 0, ValueIsNotParsable(value, format, reason)
+	}
+	return t, fields.present, nil
+}
+
+// parseToken reads given FormatToken from the start of value and returns the rest of value
+func (f *parsedFields) parseToken(value string, token FormatToken, locale Locale, mode ParseMode) (string, bool) {
+	original := value
+	var ok bool
+	switch token {
+	case YearFull:
+		f.year, value, ok = parseNumber(value, 4, 4)
+	case YearShort:
+		var year int
+		year, value, ok = parseNumber(value, 2, 2)
+		// the same pivot year as time.Parse
+		f.year = year + 1900
+		if year < 69 {
+			f.year = year + 2000
+		}
+	case MonthFull:
+		f.month, value, ok = parseName(value, locale.Months[:], mode)
+		f.month++
+	case MonthAbbr:
+		f.month, value, ok = parseName(value, locale.MonthsShort[:], mode)
+		f.month++
+	case MonthZeroPadded:
+		f.month, value, ok = parseDigits(value, 2, true, mode)
+	case MonthShort:
+		f.month, value, ok = parseDigits(value, 2, false, mode)
+	case DayOfYearZeroPadded:
+		f.yearDay, value, ok = parseDigits(value, 3, true, mode)
+	case DayOfMonthZeroPadded:
+		f.day, value, ok = parseDigits(value, 2, true, mode)
+	case DayOfMonthShort:
+		f.day, value, ok = parseDigits(value, 2, false, mode)
+	case DayOfMonthOrdinal:
+		f.day, value, ok = parseOrdinal(value, locale)
+	case DayOfWeekFullName:
+		f.weekday, value, ok = parseName(value, locale.Weekdays[:], mode)
+	case DayOfWeekAbbr:
+		f.weekday, value, ok = parseName(value, locale.WeekdaysShort[:], mode)
+	case IsoWeekYear:
+		f.isoYear, value, ok = parseNumber(value, 4, 4)
+	case IsoWeekOfYearZeroPadded:
+		f.week, value, ok = parseDigits(value, 2, true, mode)
+		f.weekRule = weekRuleIso
+	case IsoWeekOfYear:
+		f.week, value, ok = parseDigits(value, 2, false, mode)
+		f.weekRule = weekRuleIso
+	case strptimeSundayWeek:
+		f.week, value, ok = parseDigits(value, 2, true, mode)
+		f.weekRule = weekRuleSunday
+	case strptimeMondayWeek:
+		f.week, value, ok = parseDigits(value, 2, true, mode)
+		f.weekRule = weekRuleMonday
+	case strptimeIsoWeekday:
+		f.weekday, value, ok = parseNumber(value, 1, 1)
+		ok = ok && f.weekday >= 1 && f.weekday <= 7
+		f.weekday %= 7
+	case strptimeWeekday:
+		f.weekday, value, ok = parseNumber(value, 1, 1)
+		ok = ok && f.weekday <= 6
+	case strptimeDay:
+		f.day, value, ok = parseNumber(strings.TrimPrefix(value, " "), 1, 2)
+	case TwentyFourHourZeroPadded:
+		// HH accepts one digit like time.Parse in ParseModeDefault
+		f.hour, value, ok = parseDigits(value, 2, mode != ParseModeDefault, mode)
+	case TwentyFourHour:
+		f.hour, value, ok = parseDigits(value, 2, false, mode)
+	case TwelveHourZeroPadded:
+		f.hour, value, ok = parseDigits(value, 2, true, mode)
+		ok = ok && f.hour <= 12
+	case TwelveHour:
+		f.hour, value, ok = parseDigits(value, 2, false, mode)
+		ok = ok && f.hour <= 12
+	case AMPMUpper:
+		f.meridiem, value, ok = parsePrefix(value, locale.Meridiem[:], mode)
+	case AMPMLower:
+		f.meridiem, value, ok = parsePrefix(
+			value,
+			[]string{strings.ToLower(locale.Meridiem[0]), strings.ToLower(locale.Meridiem[1])},
+			mode,
+		)
+	case MinuteZeroPadded:
+		f.minute, value, ok = parseDigits(value, 2, true, mode)
+	case Minute:
+		f.minute, value, ok = parseDigits(value, 2, false, mode)
+	case SecondZeroPadded:
+		f.second, value, ok = parseDigits(value, 2, true, mode)
+	case Second:
+		f.second, value, ok = parseDigits(value, 2, false, mode)
+	case MicroSecond:
+		// ParseModeLenient accepts fewer digits like Python's strptime, ".5" is 500000 microseconds
+		minDigits := 6
+		if mode == ParseModeLenient {
+			minDigits = 1
+		}
+		var microsecond int
+		microsecond, value, ok = parseNumber(value, minDigits, 6)
+		for digits := len(original) - len(value); digits < 6; digits++ {
+			microsecond *= 10
+		}
+		f.nanosecond = microsecond * int(time.Microsecond)
+	case TimezoneFullName:
+		f.zoneName, value, ok = parseZoneName(value)
+	case TimezoneWithColon, TimezoneWithoutColon:
+		f.isUTC = strings.HasPrefix(value, "Z")
+		withColon := token == TimezoneWithColon
+		f.offset, value, ok = parseOffset(value, withColon)
+		// ParseModeLenient accepts offsets with and without colon, like Python's strptime
+		if !ok && mode == ParseModeLenient {
+			f.offset, value, ok = parseOffset(value, !withColon)
+		}
+		f.hasOffset = true
+	}
+	if !ok {
+		return original, false
+	}
+	f.present |= tokenFields[token]
+	return value, true
+}
+
+// parseFraction reads an optional fractional second, like ".123" or ",5", from the start of value
+// and returns the rest of value. Like time.Parse, digits after the ninth are ignored.
+func (f *parsedFields) parseFraction(value string) string {
+	if len(value) < 2 || (value[0] != '.' && value[0] != ',') || value[1] < '0' || value[1] > '9' {
+		return value
+	}
+	digits := 1
+	for digits < len(value) && value[digits] >= '0' && value[digits] <= '9' {
+		digits++
+	}
+	fraction := value[1:min(digits, 10)]
+	nanosecond, _, _ := parseNumber(fraction, 1, 9)
+	for range 9 - len(fraction) {
+		nanosecond *= 10
+	}
+	f.nanosecond = nanosecond
+	f.present |= FieldNanosecond
+	return value[digits:]
+}
+
+// fill sets the missing fields, which are more significant than the most significant present field, from reference.
+// Less significant missing fields stay zero.
+// If neither a date nor a time field is present, the date of reference is used.
+// A weekday without date is resolved in the week of reference.
+func (f *parsedFields) fill(reference time.Time) {
+	mostSignificant := slices.IndexFunc(significantFields, func(field Field) bool {
+		return f.present&field != 0
+	})
+	if mostSignificant < 0 {
+		mostSignificant = slices.Index(significantFields, FieldHour)
+	}
+	for _, field := range significantFields[:mostSignificant] {
+		switch field {
+		case FieldYear:
+			f.year = reference.Year()
+		case FieldMonth:
+			f.month = int(reference.Month())
+		case FieldDay:
+			f.day = reference.Day()
+		case FieldHour:
+			f.hour = reference.Hour()
+		case FieldMinute:
+			f.minute = reference.Minute()
+		case FieldSecond:
+			f.second = reference.Second()
+		}
+	}
+	// a weekday without date is the day in the week of reference, which starts on the first day of DefaultWeekCalendar
+	if f.present&FieldWeekday != 0 && f.present&FieldDate == 0 {
+		firstDay := DefaultWeekCalendar().FirstDay
+		days := daysSinceWeekday(time.Weekday(f.weekday), firstDay) - daysSinceWeekday(reference.Weekday(), firstDay)
+		date := reference.AddDate(0, 0, days)
+		f.year, f.month, f.day = date.Year(), int(date.Month()), date.Day()
+	}
+}
+
+// toTime validates the fields and returns the time.Time of them in given location.
+// The returned reason is not empty if the fields are invalid.
+func (f parsedFields) toTime(location *time.Location) (time.Time, string) {
+	hour := f.hour
+	switch {
+	case f.meridiem == 1 && hour < 12:
+		hour += 12
+	case f.meridiem == 0 && hour == 12:
+		hour = 0
+	}
+
+	year, month, day := f.year, f.month, f.day
+	if f.yearDay >= 0 {
+		daysInYear := 365
+		if isLeapYear(f.year) {
+			daysInYear = 366
+		}
+		if f.yearDay < 1 || f.yearDay > daysInYear {
+			return time.Time{}, fmt.Sprintf("day of year %d is out of range", f.yearDay)
+		}
+		date := time.Date(f.year, time.January, f.yearDay, 0, 0, 0, 0, time.UTC)
+		if (month >= 0 && month != int(date.Month())) || (day >= 0 && day != date.Day()) {
+			return time.Time{}, fmt.Sprintf("day of year %d does not match the month and day", f.yearDay)
+		}
+		month, day = int(date.Month()), date.Day()
+	}
+	if f.week >= 0 {
+		date, reason := f.weekDate()
+		if reason != "" {
+			return time.Time{}, reason
+		}
+		if (month >= 0 && month != int(date.Month())) || (day >= 0 && day != date.Day()) {
+			return time.Time{}, fmt.Sprintf("week %d does not match the month and day", f.week)
+		}
+		year, month, day = date.Year(), int(date.Month()), date.Day()
+	}
+	if month < 0 {
+		month = 1
+	}
+	if day < 0 {
+		day = 1
+	}
+
+	switch {
+	case month < 1 || month > 12:
+		return time.Time{}, fmt.Sprintf("month %d is out of range", month)
+	case day < 1 || day > daysIn(time.Month(month), year):
+		return time.Time{}, fmt.Sprintf("day %d is out of range", day)
+	case hour > 23:
+		return time.Time{}, fmt.Sprintf("hour %d is out of range", hour)
+	case f.minute > 59:
+		return time.Time{}, fmt.Sprintf("minute %d is out of range", f.minute)
+	case f.second > 59:
+		return time.Time{}, fmt.Sprintf("second %d is out of range", f.second)
+	}
+
+	date := func(location *time.Location) time.Time {
+		return time.Date(year, time.Month(month), day, hour, f.minute, f.second, f.nanosecond, location)
+	}
+	if f.isUTC {
+		return date(time.UTC), ""
+	}
+	if f.hasOffset {
+		t := date(time.UTC).Add(-time.Duration(f.offset) * time.Second)
+		// prefer the location, if it has the parsed offset at that time, like time.Parse does
+		if _, offset := t.In(location).Zone(); offset == f.offset {
+			return t.In(location), ""
+		}
+		return t.In(time.FixedZone("", f.offset)), ""
+	}
+	if f.zoneName == "UTC" {
+		return date(time.UTC), ""
+	}
+	if f.zoneName != "" {
+		// like time.Parse, the offset of an abbreviation of the location is used, even if it was not in effect
+		if offset, ok := zoneOffset(location, f.zoneName, date(time.UTC)); ok {
+			return date(time.UTC).Add(-time.Duration(offset) * time.Second).In(location), ""
+		}
+		// like time.Parse, unknown abbreviations get a zero offset
+		// and "GMT+3" like abbreviations only the offset of their zone, keeping the wall clock as UTC
+		offset := 0
+		if hours, ok := strings.CutPrefix(f.zoneName, "GMT"); ok && hours != "" {
+			offset, _, _ = parseSignedNumber(hours)
+			offset *= 3600
+		}
+		return date(time.UTC).In(time.FixedZone(f.zoneName, offset)), ""
+	}
+	return date(location), ""
+}
+
+// weekDate returns the date of the week and weekday, a missing weekday is the first day of the week.
+// The returned reason is not empty if the week is out of range.
+func (f parsedFields) weekDate() (time.Time, string) {
+	first := time.Monday
+	if f.weekRule == weekRuleSunday {
+		first = time.Sunday
+	}
+	weekday := first
+	if f.weekday >= 0 {
+		weekday = time.Weekday(f.weekday)
+	}
+
+	if f.weekRule == weekRuleIso {
+		year := f.isoYear
+		if year < 0 {
+			year = f.year
+		}
+		// the first ISO week contains the 4th of january
+		january4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+		firstDay := january4.AddDate(0, 0, -daysSinceWeekday(january4.Weekday(), first))
+		date := firstDay.AddDate(0, 0, (f.week-1)*WeekInDays+daysSinceWeekday(weekday, first))
+		if isoYear, isoWeek := date.ISOWeek(); isoYear != year || isoWeek != f.week {
+			return time.Time{}, fmt.Sprintf("ISO week %d is out of range", f.week)
+		}
+		return date, ""
+	}
+
+	january1 := time.Date(f.year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	firstDay := january1.AddDate(0, 0, daysSinceWeekday(first, january1.Weekday()))
+	date := firstDay.AddDate(0, 0, (f.week-1)*WeekInDays+daysSinceWeekday(weekday, first))
+	if date.Year() != f.year {
+		return time.Time{}, fmt.Sprintf("week %d is out of range", f.week)
+	}
+	return date, ""
+}
+
+// zoneOffset returns the offset in seconds of the abbreviation name in location for the wall clock in UTC.
+// The offset in effect at wall is preferred, otherwise the offset of name at another time of the year is used,
+// like "CEST" in winter.
+func zoneOffset(location *time.Location, name string, wall time.Time) (int, bool) {
+	candidates := []time.Time{time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), 0, 0, location)}
+	for month := time.January; month <= time.December; month++ {
+		candidates = append(candidates, time.Date(wall.Year(), month, 1, 12, 0, 0, 0, location))
+	}
+	for _, candidate := range candidates {
+		if candidateName, offset := candidate.Zone(); candidateName == name {
+			return offset, true
+		}
+	}
+	return 0, false
+}
+
+// skipLiteral skips literal at the start of value.
+// Like time.Parse, spaces in literal match one or more spaces in value in ParseModeDefault.
+// ParseModeStrict matches spaces exactly and ParseModeLenient ignores all whitespace around literal.
+func skipLiteral(value string, literal string, mode ParseMode) (string, bool) {
+	if mode == ParseModeLenient {
+		return skipLenientLiteral(value, literal)
+	}
+	for literal != "" {
+		if literal[0] == ' ' && mode == ParseModeDefault {
+			if value == "" || value[0] != ' ' {
+				return value, false
+			}
+			literal = strings.TrimLeft(literal, " ")
+			value = strings.TrimLeft(value, " ")
+			continue
+		}
+		if value == "" || value[0] != literal[0] {
+			return value, false
+		}
+		literal, value = literal[1:], value[1:]
+	}
+	return value, true
+}
+
+// skipLenientLiteral skips literal at the start of value, ignoring whitespace before, in and after literal
+func skipLenientLiteral(value string, literal string) (string, bool) {
+	rest := strings.TrimLeftFunc(value, unicode.IsSpace)
+	for _, part := range strings.Fields(literal) {
+		var ok bool
+		if rest, ok = strings.CutPrefix(rest, part); !ok {
+			return value, false
+		}
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+	}
+	return rest, true
+}
+
+// parseDigits reads a number with up to maxDigits digits from the start of value.
+// A padded number has exactly maxDigits digits, except in ParseModeLenient.
+// An unpadded number has no leading zero in ParseModeStrict.
+func parseDigits(value string, maxDigits int, padded bool, mode ParseMode) (int, string, bool) {
+	switch {
+	case padded && mode != ParseModeLenient:
+		return parseNumber(value, maxDigits, maxDigits)
+	case !padded && mode == ParseModeStrict && len(value) > 1 && value[0] == '0' && unicode.IsDigit(rune(value[1])):
+		return 0, value, false
+	}
+	return parseNumber(value, 1, maxDigits)
+}
+
+// parseNumber reads a number of minDigits to maxDigits digits from the start of value
+func parseNumber(value string, minDigits int, maxDigits int) (int, string, bool) {
+	digits := 0
+	for digits < maxDigits && digits < len(value) && value[digits] >= '0' && value[digits] <= '9' {
+		digits++
+	}
+	if digits < minDigits {
+		return 0, value, false
+	}
+	number := 0
+	for _, digit := range value[:digits] {
+		number = number*10 + int(digit-'0')
+	}
+	return number, value[digits:], true
+}
+
+// parseName reads the longest of names from the start of value and returns its index.
+// The case is ignored, except in ParseModeStrict.
+func parseName(value string, names []string, mode ParseMode) (int, string, bool) {
+	equal := strings.EqualFold
+	if mode == ParseModeStrict {
+		equal = func(a string, b string) bool { return a == b }
+	}
+	index, length := -1, 0
+	for i, name := range names {
+		if len(name) > length && len(value) >= len(name) && equal(value[:len(name)], name) {
+			index, length = i, len(name)
+		}
+	}
+	if index < 0 {
+		return 0, value, false
+	}
+	return index, value[length:], true
+}
+
+// parsePrefix reads the longest of prefixes from the start of value and returns its index.
+// The case is ignored in ParseModeLenient.
+func parsePrefix(value string, prefixes []string, mode ParseMode) (int, string, bool) {
+	index, length := -1, 0
+	for i, prefix := range prefixes {
+		matches := strings.HasPrefix(value, prefix)
+		if mode == ParseModeLenient {
+			matches = len(value) >= len(prefix) && strings.EqualFold(value[:len(prefix)], prefix)
+		}
+		if len(prefix) > length && matches {
+			index, length = i, len(prefix)
+		}
+	}
+	if index < 0 {
+		return 0, value, false
+	}
+	return index, value[length:], true
+}
+
+// parseOrdinal reads a day of month with the ordinal suffix of given Locale from the start of value.
+// Only the suffix of the day is read and it has to match the day, like "1st" but not "1th".
+func parseOrdinal(value string, locale Locale) (int, string, bool) {
+	for digits := 2; digits >= 1; digits-- {
+		day, _, ok := parseNumber(value, digits, digits)
+		if !ok {
+			continue
+		}
+		if ordinal := locale.ordinal(day); strings.HasPrefix(value, ordinal) {
+			return day, value[len(ordinal):], true
+		}
+	}
+	return 0, value, false
+}
+
+// parseZoneName reads a timezone abbreviation, like "CET", "UTC", "GMT+3", "ChST" or "+03", from the start of value.
+// The abbreviations are the ones time.Parse accepts.
+func parseZoneName(value string) (string, string, bool) {
+	length := zoneNameLength(value)
+	if length == 0 {
+		return "", value, false
+	}
+	return value[:length], value[length:], true
+}
+
+// zoneNameLength returns the length of the timezone abbreviation at the start of value or zero, like time.Parse
+func zoneNameLength(value string) int {
+	switch {
+	case len(value) < 3:
+		return 0
+	case strings.HasPrefix(value, "ChST") || strings.HasPrefix(value, "MeST"):
+		return 4
+	case strings.HasPrefix(value, "GMT"):
+		_, rest, _ := parseSignedNumber(value[3:])
+		return len(value) - len(rest)
+	case value[0] == '+' || value[0] == '-':
+		_, rest, _ := parseSignedNumber(value)
+		return len(value) - len(rest)
+	}
+
+	upper := 0
+	for upper < len(value) && upper < 6 && value[upper] >= 'A' && value[upper] <= 'Z' {
+		upper++
+	}
+	switch {
+	case upper == 3:
+		return 3
+	case upper == 4 && (value[3] == 'T' || value[:4] == "WITA"):
+		return 4
+	case upper == 5 && value[4] == 'T':
+		return 5
+	}
+	return 0
+}
+
+// parseSignedNumber reads a number with a leading "+" or "-" from the start of value
+func parseSignedNumber(value string) (int, string, bool) {
+	if value == "" || (value[0] != '+' && value[0] != '-') {
+		return 0, value, false
+	}
+	number, rest, ok := parseNumber(value[1:], 1, 9)
+	if !ok {
+		return 0, value, false
+	}
+	if value[0] == '-' {
+		number = -number
+	}
+	return number, rest, true
+}
+
+// parseOffset reads a timezone offset, like "Z", "+0100" or with colon "+01:00", from the start of value
+// and returns it in seconds east of UTC
+func parseOffset(value string, withColon bool) (int, string, bool) {
+	if strings.HasPrefix(value, "Z") {
+		return 0, value[1:], true
+	}
+	if value == "" || (value[0] != '+' && value[0] != '-') {
+		return 0, value, false
+	}
+	sign := 1
+	if value[0] == '-' {
+		sign = -1
+	}
+	hours, rest, ok := parseNumber(value[1:], 2, 2)
+	if !ok {
+		return 0, value, false
+	}
+	if withColon {
+		if rest, ok = strings.CutPrefix(rest, ":"); !ok {
+			return 0, value, false
+		}
+	}
+	minutes, rest, ok := parseNumber(rest, 2, 2)
+	if !ok || minutes > 59 {
+		return 0, value, false
+	}
+	return sign * (hours*3600 + minutes*60), rest, true
+}
+
+// isLeapYear checks if given year of the gregorian calendar has 366 days
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// daysIn returns the days of given month in given year
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,184 @@
+package gostradamus
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Locale holds the names and rules to format and parse DateTimes in a language
+type Locale struct {
+	// Code is the BCP 47 language code of the Locale, like "de"
+	Code string
+	// Months are the names of the months from January to December, used by MMMM
+	Months [12]string
+	// MonthsShort are the abbreviated names of the months from January to December, used by MMM
+	MonthsShort [12]string
+	// Weekdays are the names of the weekdays from sunday to saturday, used by dddd
+	Weekdays [7]string
+	// WeekdaysShort are the abbreviated names of the weekdays from sunday to saturday, used by ddd
+	WeekdaysShort [7]string
+	// Meridiem are the markers before and after noon, used by A and in lower case by a
+	Meridiem [2]string
+	// Ordinal returns the day of month with its ordinal suffix, used by Do.
+	// A nil Ordinal formats the plain day.
+	Ordinal func(day int) string
+	// WeekCalendar is used by the week tokens gggg, ww and w
+	WeekCalendar WeekCalendar
+	// DateFormats are the date formats of FormatStyleShort, FormatStyleMedium, FormatStyleLong and FormatStyleFull
+	DateFormats [4]string
+	// TimeFormats are the time formats of FormatStyleShort, FormatStyleMedium, FormatStyleLong and FormatStyleFull
+	TimeFormats [4]string
+	// DateTimeFormats combine the date and time format of FormatStyleShort, FormatStyleMedium, FormatStyleLong
+	// and FormatStyleFull, "{date}" and "{time}" are replaced by them
+	DateTimeFormats [4]string
+	// LocalizedFormats are the formats of the localized FormatTokens L, LL, LLL, LLLL, LT and LTS
+	LocalizedFormats LocalizedFormats
+	// RelativeTimeFormats are the texts of relative times, like "3 hours ago"
+	RelativeTimeFormats RelativeTimeFormats
+	// CalendarFormats are the formats of DateTimes relative to a day, like "[Yesterday at] LT"
+	CalendarFormats CalendarFormats
+}
+
+// LocalizedFormats are the formats of the localized FormatTokens.
+// They are presets like the ones of moment.js and independent of the FormatStyles.
+type LocalizedFormats struct {
+	// LT is the time, like "3:07 PM"
+	LT string
+	// LTS is the time with seconds, like "3:07:08 PM"
+	LTS string
+	// L is the numeric date, like "04/05/2011"
+	L string
+	// LL is the date with the name of the month, like "April 5, 2011"
+	LL string
+	// LLL is the date with the name of the month and the time, like "April 5, 2011 at 3:07 PM"
+	LLL string
+	// LLLL is the date with the weekday, the name of the month and the time,
+	// like "Tuesday, April 5, 2011 at 3:07 PM"
+	LLLL string
+}
+
+// FormatStyle is the length of the date and time patterns of the Unicode CLDR in a Locale
+type FormatStyle int
+
+// All FormatStyles
+const (
+	// FormatStyleShort is numeric, like "4/5/11" and "3:07 PM"
+	FormatStyleShort FormatStyle = iota
+	// FormatStyleMedium has abbreviated names, like "Apr 5, 2011" and "3:07:08 PM"
+	FormatStyleMedium
+	// FormatStyleLong has full names, like "April 5, 2011" and "3:07:08 PM UTC"
+	FormatStyleLong
+	// FormatStyleFull has full names and the weekday, like "Tuesday, April 5, 2011" and "3:07:08 PM UTC".
+	// The timezone is abbreviated, because there is no FormatToken for the long name of a timezone.
+	FormatStyleFull
+)
+
+// LookupLocale returns the built-in Locale of given language code, like "de".
+// Region subtags are ignored, so "de-AT" and "de_CH" return the german Locale as well.
+// The returned bool is false if there is no built-in Locale for the language.
+func LookupLocale(code string) (Locale, bool) {
+	language, _, _ := strings.Cut(strings.ReplaceAll(code, "_", "-"), "-")
+	locale, ok := localesByLanguage[strings.ToLower(language)]
+	return locale, ok
+}
+
+// FormatLocale the current DateTime with given format in given Locale to a string
+//
+// For Example with GermanLocale:
+//
+//	"dddd, Do MMMM YYYY" becomes "Dienstag, 5. April 2011"
+func (dt DateTime) FormatLocale(format string, locale Locale) string {
+	return formatFromTimeInLocale(dt.Time(), format, locale)
+}
+
+// FormatStyle the current DateTime with the date and time format of given FormatStyle in given Locale to a string
+//
+// For Example with EnglishLocale:
+//
+//	FormatStyleLong becomes "April 5, 2011 at 3:07:08 PM UTC"
+func (dt DateTime) FormatStyle(style FormatStyle, locale Locale) string {
+	return dt.FormatLocale(locale.DateTimeFormat(style), locale)
+}
+
+// FormatDateStyle the current DateTime with the date format of given FormatStyle in given Locale to a string
+func (dt DateTime) FormatDateStyle(style FormatStyle, locale Locale) string {
+	return dt.FormatLocale(locale.DateFormats[style], locale)
+}
+
+// FormatTimeStyle the current DateTime with the time format of given FormatStyle in given Locale to a string
+func (dt DateTime) FormatTimeStyle(style FormatStyle, locale Locale) string {
+	return dt.FormatLocale(locale.TimeFormats[style], locale)
+}
+
+// DateTimeFormat returns the format of the date and time of given FormatStyle
+//
+// For Example with GermanLocale:
+//
+//	FormatStyleLong becomes "D. MMMM YYYY [um] HH:mm:ss ZZZ"
+func (l Locale) DateTimeFormat(style FormatStyle) string {
+	return strings.NewReplacer("{date}", l.DateFormats[style], "{time}", l.TimeFormats[style]).Replace(
+		l.DateTimeFormats[style],
+	)
+}
+
+// ParseLocale a string value with given format in given Locale into a new DateTime
+func ParseLocale(value string, format string, locale Locale) (DateTime, error) {
+	parsedTime, err := parseToTimeInLocale(value, format, UTC, locale)
+	return DateTimeFromTime(parsedTime), err
+}
+
+// ParseLocaleInTimezone a string value with given format in given Locale into a new DateTime in given timezone
+func ParseLocaleInTimezone(value string, format string, timezone Timezone, locale Locale) (DateTime, error) {
+	parsedTime, err := parseToTimeInLocale(value, format, timezone, locale)
+	return DateTimeFromTime(parsedTime), err
+}
+
+// defaultLocale returns the Locale of Format and Parse, which is EnglishLocale with the DefaultWeekCalendar
+func defaultLocale() Locale {
+	locale := EnglishLocale
+	locale.WeekCalendar = DefaultWeekCalendar()
+	return locale
+}
+
+// localizedFormat returns the format of given localized FormatToken.
+// The returned bool is false if the FormatToken is not localized.
+func (l Locale) localizedFormat(token FormatToken) (string, bool) {
+	switch token {
+	case LocalizedDate:
+		return l.LocalizedFormats.L, true
+	case LocalizedDateLong:
+		return l.LocalizedFormats.LL, true
+	case LocalizedDateTime:
+		return l.LocalizedFormats.LLL, true
+	case LocalizedDateTimeFull:
+		return l.LocalizedFormats.LLLL, true
+	case LocalizedTime:
+		return l.LocalizedFormats.LT, true
+	case LocalizedTimeWithSeconds:
+		return l.LocalizedFormats.LTS, true
+	}
+	return "", false
+}
+
+func (l Locale) month(month time.Month) string {
+	return l.Months[month-1]
+}
+
+func (l Locale) monthShort(month time.Month) string {
+	return l.MonthsShort[month-1]
+}
+
+func (l Locale) meridiem(hour int) string {
+	if hour < 12 {
+		return l.Meridiem[0]
+	}
+	return l.Meridiem[1]
+}
+
+func (l Locale) ordinal(day int) string {
+	if l.Ordinal == nil {
+		return fmt.Sprint(day)
+	}
+	return l.Ordinal(day)
+}
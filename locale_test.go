@@ -0,0 +1,266 @@
+package gostradamus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupLocale(t *testing.T) {
+	locale, ok := LookupLocale("de")
+	assert.True(t, ok)
+	assert.Equal(t, "de", locale.Code)
+
+	locale, ok = LookupLocale("FR_ca")
+	assert.True(t, ok)
+	assert.Equal(t, "fr", locale.Code)
+
+	locale, ok = LookupLocale("ja-JP")
+	assert.True(t, ok)
+	assert.Equal(t, "ja", locale.Code)
+
+	_, ok = LookupLocale("xx")
+	assert.False(t, ok)
+}
+
+func TestDateTime_FormatLocale(t *testing.T) {
+	dateTime := NewUTCDateTime(2011, 4, 5, 15, 7, 8, 0)
+
+	assert.Equal(
+		t,
+		"Tuesday, 5th April 2011 Apr Tue 3 PM pm",
+		dateTime.FormatLocale("dddd, Do MMMM YYYY MMM ddd h A a", EnglishLocale),
+	)
+	assert.Equal(
+		t,
+		"Dienstag, 5. April 2011 Apr. Di.",
+		dateTime.FormatLocale("dddd, Do MMMM YYYY MMM ddd", GermanLocale),
+	)
+	assert.Equal(
+		t,
+		"mardi 5 avril 2011 avr. mar.",
+		dateTime.FormatLocale("dddd Do MMMM YYYY MMM ddd", FrenchLocale),
+	)
+	assert.Equal(t, "1er", NewUTCDateTime(2011, 4, 1, 0, 0, 0, 0).FormatLocale("Do", FrenchLocale))
+	assert.Equal(
+		t,
+		"2011年4月5日 火曜日 午後3:07",
+		dateTime.FormatLocale("YYYY年MMMMDo dddd Ah:mm", JapaneseLocale),
+	)
+	assert.Equal(t, "3 p.\u00a0m. 3 p.\u00a0m.", dateTime.FormatLocale("h A h a", SpanishLocale))
+}
+
+func TestDateTime_FormatLocale_Week(t *testing.T) {
+	// 2022-01-02 is a sunday
+	dateTime := NewUTCDateTime(2022, 1, 2, 0, 0, 0, 0)
+	assert.Equal(t, "2022-02 2021-52", dateTime.FormatLocale("gggg-ww GGGG-WW", EnglishLocale))
+	assert.Equal(t, "2021-52 2021-52", dateTime.FormatLocale("gggg-ww GGGG-WW", GermanLocale))
+}
+
+func TestParseLocale(t *testing.T) {
+	actual, err := ParseLocale("Dienstag, 5. April 2011", "dddd, Do MMMM YYYY", GermanLocale)
+	assert.NoError(t, err)
+	assert.Equal(t, NewUTCDateTime(2011, 4, 5, 0, 0, 0, 0), actual)
+
+	actual, err = ParseLocale("5 Sept. 2011", "D MMM YYYY", GermanLocale)
+	assert.NoError(t, err)
+	assert.Equal(t, NewUTCDateTime(2011, 9, 5, 0, 0, 0, 0), actual)
+
+	actual, err = ParseLocale("1er AOÛT 2019", "Do MMMM YYYY", FrenchLocale)
+	assert.NoError(t, err)
+	assert.Equal(t, NewUTCDateTime(2019, 8, 1, 0, 0, 0, 0), actual)
+
+	actual, err = ParseLocale("2011年12月5日 午後3:07", "YYYY年MMMMDo Ah:mm", JapaneseLocale)
+	assert.NoError(t, err)
+	assert.Equal(t, NewUTCDateTime(2011, 12, 5, 15, 7, 0, 0), actual)
+
+	actual, err = ParseLocale("Thursday 1st August 2019 12:30 am", "dddd Do MMMM YYYY hh:mm a", EnglishLocale)
+	assert.NoError(t, err)
+	assert.Equal(t, NewUTCDateTime(2019, 8, 1, 0, 30, 0, 0), actual)
+
+	actual, err = ParseLocale("22nd", "Do", EnglishLocale)
+	assert.NoError(t, err)
+	assert.Equal(t, NewUTCDateTime(0, 1, 22, 0, 0, 0, 0), actual)
+}
+
+func TestParseLocale_Timezone(t *testing.T) {
+	actual, err := ParseLocale("2012-12-12T12:12:12.000001+0100", Iso8601TZ, EnglishLocale)
+	assert.NoError(t, err)
+	assert.Equal(t, "2012-12-12T11:12:12.000001Z", actual.InTimezone(UTC).String())
+	_, offset := actual.Time().Zone()
+	assert.Equal(t, 3600, offset)
+
+	actual, err = ParseLocaleInTimezone("2012-12-12 12:12 +01:00", "YYYY-MM-DD HH:mm zz", EuropeBerlin, EnglishLocale)
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2012, 12, 12, 12, 12, 0, 0, EuropeBerlin), actual)
+
+	actual, err = ParseLocaleInTimezone("2012-12-12 12:12 CET", "YYYY-MM-DD HH:mm ZZZ", EuropeBerlin, EnglishLocale)
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2012, 12, 12, 12, 12, 0, 0, EuropeBerlin), actual)
+
+	actual, err = ParseLocaleInTimezone("2012-12-12 12:12", "YYYY-MM-DD HH:mm", AsiaKathmandu, EnglishLocale)
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2012, 12, 12, 12, 12, 0, 0, AsiaKathmandu), actual)
+}
+
+func TestParseLocale_DayOfYear(t *testing.T) {
+	actual, err := ParseLocale("2012-060", "YYYY-DDDD", EnglishLocale)
+	assert.NoError(t, err)
+	assert.Equal(t, NewUTCDateTime(2012, 2, 29, 0, 0, 0, 0), actual)
+
+	_, err = ParseLocale("2011-366", "YYYY-DDDD", EnglishLocale)
+	assert.EqualError(t, err, `Value: "2011-366" is not parsable as "YYYY-DDDD", day of year 366 is out of range`)
+
+	_, err = ParseLocale("2012-03-060", "YYYY-MM-DDDD", EnglishLocale)
+	assert.EqualError(
+		t,
+		err,
+		`Value: "2012-03-060" is not parsable as "YYYY-MM-DDDD", day of year 60 does not match the month and day`,
+	)
+}
+
+func TestParseLocale_Error(t *testing.T) {
+	_, err := ParseLocale("5. Avril 2011", "Do MMMM YYYY", GermanLocale)
+	assert.EqualError(t, err, `Value: "5. Avril 2011" is not parsable as "Do MMMM YYYY", "Avril 2011" does not match MMMM`)
+
+	_, err = ParseLocale("1th August", "Do MMMM", EnglishLocale)
+	assert.EqualError(t, err, `Value: "1th August" is not parsable as "Do MMMM", "1th August" does not match Do`)
+
+	_, err = ParseLocale("2019-02-29", "YYYY-MM-DD", EnglishLocale)
+	assert.EqualError(t, err, `Value: "2019-02-29" is not parsable as "YYYY-MM-DD", day 29 is out of range`)
+
+	_, err = ParseLocale("2019-02-28 ", "YYYY-MM-DD", EnglishLocale)
+	assert.EqualError(t, err, `Value: "2019-02-28 " is not parsable as "YYYY-MM-DD", " " is left over`)
+
+	_, err = ParseLocale("2019/02/28", "YYYY-MM-DD", EnglishLocale)
+	assert.EqualError(t, err, `Value: "2019/02/28" is not parsable as "YYYY-MM-DD", "/02/28" does not match "-"`)
+
+	_, err = ParseLocale("13:00 PM", "hh:mm A", EnglishLocale)
+	assert.EqualError(t, err, `Value: "13:00 PM" is not parsable as "hh:mm A", "13:00 PM" does not match hh`)
+
+	_, err = ParseLocale("2012 01", "YYYY ww", EnglishLocale)
+	assert.EqualError(t, err, "FormatToken: ww is not parsable")
+}
+
+func TestParseLocale_FormatLocale(t *testing.T) {
+	format := "dddd ddd Do MMMM MMM YYYY hh:mm:ss.S a"
+	for _, locale := range localesByLanguage {
+		for month := time.January; month <= time.December; month++ {
+			expected := NewUTCDateTime(2019, int(month), int(month)*2, int(month)*2-1, 30, 15, 123456000)
+			actual, err := ParseLocale(expected.FormatLocale(format, locale), format, locale)
+			assert.NoError(t, err, locale.Code)
+			assert.Equal(t, expected, actual, locale.Code)
+		}
+	}
+}
+
+func TestDateTime_FormatLocale_LocalizedTokens(t *testing.T) {
+	dateTime := NewUTCDateTime(2011, 4, 5, 15, 7, 8, 0)
+
+	assert.Equal(
+		t,
+		"04/05/2011|April 5, 2011|April 5, 2011 at 3:07 PM|Tuesday, April 5, 2011 at 3:07 PM|3:07 PM|3:07:08 PM",
+		dateTime.FormatLocale("L|LL|LLL|LLLL|LT|LTS", EnglishLocale),
+	)
+	assert.Equal(
+		t,
+		"05.04.2011|5. April 2011|5. April 2011 um 15:07|Dienstag, 5. April 2011 um 15:07|15:07|15:07:08",
+		dateTime.FormatLocale("L|LL|LLL|LLLL|LT|LTS", GermanLocale),
+	)
+	assert.Equal(
+		t,
+		"martes, 5 de abril de 2011, 15:07",
+		dateTime.FormatLocale("LLLL", SpanishLocale),
+	)
+	assert.Equal(t, "2011年4月5日火曜日 15:07", dateTime.FormatLocale("LLLL", JapaneseLocale))
+	assert.Equal(t, "Date: 04/05/2011", dateTime.Format("[Date]: L"))
+}
+
+func TestDateTime_FormatStyle(t *testing.T) {
+	dateTime := NewDateTime(2011, 4, 5, 15, 7, 8, 0, EuropeBerlin)
+	expected := map[string][4]string{
+		"en": {
+			"4/5/11, 3:07\u202fPM",
+			"Apr 5, 2011, 3:07:08\u202fPM",
+			"April 5, 2011 at 3:07:08\u202fPM CEST",
+			"Tuesday, April 5, 2011 at 3:07:08\u202fPM CEST",
+		},
+		"de": {
+			"05.04.11, 15:07",
+			"05.04.2011, 15:07:08",
+			"5. April 2011 um 15:07:08 CEST",
+			"Dienstag, 5. April 2011 um 15:07:08 CEST",
+		},
+		"fr": {
+			"05/04/2011 15:07",
+			"5 avr. 2011, 15:07:08",
+			"5 avril 2011 à 15:07:08 CEST",
+			"mardi 5 avril 2011 à 15:07:08 CEST",
+		},
+		"es": {
+			"5/4/11, 15:07",
+			"5 abr 2011, 15:07:08",
+			"5 de abril de 2011, 15:07:08 CEST",
+			"martes, 5 de abril de 2011, 15:07:08 (CEST)",
+		},
+		"it": {
+			"05/04/11, 15:07",
+			"5 apr 2011, 15:07:08",
+			"5 aprile 2011 15:07:08 CEST",
+			"martedì 5 aprile 2011 15:07:08 CEST",
+		},
+		"nl": {
+			"05-04-2011 15:07",
+			"5 apr 2011 15:07:08",
+			"5 april 2011 om 15:07:08 CEST",
+			"dinsdag 5 april 2011 om 15:07:08 CEST",
+		},
+		"pt": {
+			"05/04/2011 15:07",
+			"5 de abr. de 2011 15:07:08",
+			"5 de abril de 2011 15:07:08 CEST",
+			"terça-feira, 5 de abril de 2011 15:07:08 CEST",
+		},
+		"ja": {
+			"2011/04/05 15:07",
+			"2011/04/05 15:07:08",
+			"2011年4月5日 15:07:08 CEST",
+			"2011年4月5日火曜日 15時07分08秒 CEST",
+		},
+	}
+	assert.Len(t, expected, len(localesByLanguage))
+	for code, locale := range localesByLanguage {
+		for style := FormatStyleShort; style <= FormatStyleFull; style++ {
+			assert.Equal(t, expected[code][style], dateTime.FormatStyle(style, locale), code)
+		}
+	}
+
+	assert.Equal(t, "5 avr. 2011", dateTime.FormatDateStyle(FormatStyleMedium, FrenchLocale))
+	assert.Equal(t, "15:07", dateTime.FormatTimeStyle(FormatStyleShort, DutchLocale))
+	assert.Equal(t, "9:05", dateTime.ShiftHours(-6).ShiftMinutes(-2).FormatTimeStyle(FormatStyleShort, JapaneseLocale))
+	assert.Equal(t, "D. MMMM YYYY [um] HH:mm:ss ZZZ", GermanLocale.DateTimeFormat(FormatStyleLong))
+}
+
+func TestParseLocale_LocalizedTokens(t *testing.T) {
+	actual, err := ParseLocale("Dienstag, 5. April 2011 um 15:07", "LLLL", GermanLocale)
+	assert.NoError(t, err)
+	assert.Equal(t, NewUTCDateTime(2011, 4, 5, 15, 7, 0, 0), actual)
+
+	actual, err = ParseLocale("5 de abril de 2011 at 3:07", "LL [at] h:mm", SpanishLocale)
+	assert.NoError(t, err)
+	assert.Equal(t, NewUTCDateTime(2011, 4, 5, 3, 7, 0, 0), actual)
+
+	for _, locale := range localesByLanguage {
+		for style := FormatStyleShort; style <= FormatStyleFull; style++ {
+			expected := NewUTCDateTime(2019, 11, 28, 16, 30, 15, 0)
+			format := locale.DateTimeFormat(style)
+			actual, err := ParseLocale(expected.FormatLocale(format, locale), format, locale)
+			assert.NoError(t, err, locale.Code)
+			if style == FormatStyleShort {
+				expected = expected.FloorMinute()
+			}
+			assert.Equal(t, expected, actual, locale.Code)
+		}
+	}
+}
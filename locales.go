@@ -0,0 +1,301 @@
+package gostradamus
+
+import (
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+)
+
+//go:generate go run ./internal/gencldr -o locales_cldr.go
+
+// The built-in Locales use the wide and abbreviated format names and the date and time patterns
+// of the Unicode CLDR version 44 for the generic language without regional variants,
+// which are generated into cldrLocales. The other fields are written by hand.
+var (
+	// EnglishLocale is the english Locale with weeks as in the US
+	EnglishLocale = cldrLocale("en", Locale{
+		Ordinal:      humanize.Ordinal,
+		WeekCalendar: SundayWeekCalendar,
+		LocalizedFormats: LocalizedFormats{
+			LT:   "h:mm A",
+			LTS:  "h:mm:ss A",
+			L:    "MM/DD/YYYY",
+			LL:   "MMMM D, YYYY",
+			LLL:  "MMMM D, YYYY [at] h:mm A",
+			LLLL: "dddd, MMMM D, YYYY [at] h:mm A",
+		},
+		RelativeTimeFormats: RelativeTimeFormats{
+			Future: "in %s", Past: "%s ago",
+			FewSeconds: "a few seconds", Seconds: "%d seconds",
+			Minute: "a minute", Minutes: "%d minutes",
+			Hour: "an hour", Hours: "%d hours",
+			Day: "a day", Days: "%d days",
+			Month: "a month", Months: "%d months",
+			Year: "a year", Years: "%d years",
+		},
+		CalendarFormats: CalendarFormats{
+			SameDay:  "[Today at] LT",
+			NextDay:  "[Tomorrow at] LT",
+			NextWeek: "[Next] dddd [at] LT",
+			LastDay:  "[Yesterday at] LT",
+			LastWeek: "[Last] dddd [at] LT",
+			SameElse: "L",
+		},
+	})
+
+	// GermanLocale is the german Locale
+	GermanLocale = cldrLocale("de", Locale{
+		Ordinal:      suffixOrdinal("."),
+		WeekCalendar: IsoWeekCalendar,
+		LocalizedFormats: LocalizedFormats{
+			LT:   "HH:mm",
+			LTS:  "HH:mm:ss",
+			L:    "DD.MM.YYYY",
+			LL:   "D. MMMM YYYY",
+			LLL:  "D. MMMM YYYY [um] HH:mm",
+			LLLL: "dddd, D. MMMM YYYY [um] HH:mm",
+		},
+		RelativeTimeFormats: RelativeTimeFormats{
+			Future: "in %s", Past: "vor %s",
+			FewSeconds: "ein paar Sekunden", Seconds: "%d Sekunden",
+			Minute: "einer Minute", Minutes: "%d Minuten",
+			Hour: "einer Stunde", Hours: "%d Stunden",
+			Day: "einem Tag", Days: "%d Tagen",
+			Month: "einem Monat", Months: "%d Monaten",
+			Year: "einem Jahr", Years: "%d Jahren",
+		},
+		CalendarFormats: CalendarFormats{
+			SameDay:  "[heute um] LT [Uhr]",
+			NextDay:  "[morgen um] LT [Uhr]",
+			NextWeek: "dddd [um] LT [Uhr]",
+			LastDay:  "[gestern um] LT [Uhr]",
+			LastWeek: "[letzten] dddd [um] LT [Uhr]",
+			SameElse: "L",
+		},
+	})
+
+	// FrenchLocale is the french Locale
+	FrenchLocale = cldrLocale("fr", Locale{
+		Ordinal: func(day int) string {
+			if day == 1 {
+				return "1er"
+			}
+			return fmt.Sprint(day)
+		},
+		WeekCalendar: IsoWeekCalendar,
+		LocalizedFormats: LocalizedFormats{
+			LT:   "HH:mm",
+			LTS:  "HH:mm:ss",
+			L:    "DD/MM/YYYY",
+			LL:   "D MMMM YYYY",
+			LLL:  "D MMMM YYYY [à] HH:mm",
+			LLLL: "dddd D MMMM YYYY [à] HH:mm",
+		},
+		RelativeTimeFormats: RelativeTimeFormats{
+			Future: "dans %s", Past: "il y a %s",
+			FewSeconds: "quelques secondes", Seconds: "%d secondes",
+			Minute: "une minute", Minutes: "%d minutes",
+			Hour: "une heure", Hours: "%d heures",
+			Day: "un jour", Days: "%d jours",
+			Month: "un mois", Months: "%d mois",
+			Year: "un an", Years: "%d ans",
+		},
+		CalendarFormats: CalendarFormats{
+			SameDay:  "[Aujourd’hui à] LT",
+			NextDay:  "[Demain à] LT",
+			NextWeek: "dddd [à] LT",
+			LastDay:  "[Hier à] LT",
+			LastWeek: "dddd [dernier à] LT",
+			SameElse: "L",
+		},
+	})
+
+	// SpanishLocale is the spanish Locale
+	SpanishLocale = cldrLocale("es", Locale{
+		Ordinal:      suffixOrdinal("º"),
+		WeekCalendar: IsoWeekCalendar,
+		LocalizedFormats: LocalizedFormats{
+			LT:   "HH:mm",
+			LTS:  "HH:mm:ss",
+			L:    "DD/MM/YYYY",
+			LL:   "D [de] MMMM [de] YYYY",
+			LLL:  "D [de] MMMM [de] YYYY, HH:mm",
+			LLLL: "dddd, D [de] MMMM [de] YYYY, HH:mm",
+		},
+		RelativeTimeFormats: RelativeTimeFormats{
+			Future: "en %s", Past: "hace %s",
+			FewSeconds: "unos segundos", Seconds: "%d segundos",
+			Minute: "un minuto", Minutes: "%d minutos",
+			Hour: "una hora", Hours: "%d horas",
+			Day: "un día", Days: "%d días",
+			Month: "un mes", Months: "%d meses",
+			Year: "un año", Years: "%d años",
+		},
+		CalendarFormats: CalendarFormats{
+			SameDay:  "[hoy a las] LT",
+			NextDay:  "[mañana a las] LT",
+			NextWeek: "dddd [a las] LT",
+			LastDay:  "[ayer a las] LT",
+			LastWeek: "[el] dddd [pasado a las] LT",
+			SameElse: "L",
+		},
+	})
+
+	// ItalianLocale is the italian Locale
+	ItalianLocale = cldrLocale("it", Locale{
+		Ordinal:      suffixOrdinal("º"),
+		WeekCalendar: IsoWeekCalendar,
+		LocalizedFormats: LocalizedFormats{
+			LT:   "HH:mm",
+			LTS:  "HH:mm:ss",
+			L:    "DD/MM/YYYY",
+			LL:   "D MMMM YYYY",
+			LLL:  "D MMMM YYYY HH:mm",
+			LLLL: "dddd D MMMM YYYY HH:mm",
+		},
+		RelativeTimeFormats: RelativeTimeFormats{
+			Future: "tra %s", Past: "%s fa",
+			FewSeconds: "alcuni secondi", Seconds: "%d secondi",
+			Minute: "un minuto", Minutes: "%d minuti",
+			Hour: "un'ora", Hours: "%d ore",
+			Day: "un giorno", Days: "%d giorni",
+			Month: "un mese", Months: "%d mesi",
+			Year: "un anno", Years: "%d anni",
+		},
+		CalendarFormats: CalendarFormats{
+			SameDay:  "[Oggi alle] LT",
+			NextDay:  "[Domani alle] LT",
+			NextWeek: "dddd [alle] LT",
+			LastDay:  "[Ieri alle] LT",
+			LastWeek: "dddd [scorso alle] LT",
+			SameElse: "L",
+		},
+	})
+
+	// DutchLocale is the dutch Locale
+	DutchLocale = cldrLocale("nl", Locale{
+		Ordinal:      suffixOrdinal("e"),
+		WeekCalendar: IsoWeekCalendar,
+		LocalizedFormats: LocalizedFormats{
+			LT:   "HH:mm",
+			LTS:  "HH:mm:ss",
+			L:    "DD-MM-YYYY",
+			LL:   "D MMMM YYYY",
+			LLL:  "D MMMM YYYY [om] HH:mm",
+			LLLL: "dddd D MMMM YYYY [om] HH:mm",
+		},
+		RelativeTimeFormats: RelativeTimeFormats{
+			Future: "over %s", Past: "%s geleden",
+			FewSeconds: "een paar seconden", Seconds: "%d seconden",
+			Minute: "één minuut", Minutes: "%d minuten",
+			Hour: "één uur", Hours: "%d uur",
+			Day: "één dag", Days: "%d dagen",
+			Month: "één maand", Months: "%d maanden",
+			Year: "één jaar", Years: "%d jaar",
+		},
+		CalendarFormats: CalendarFormats{
+			SameDay:  "[vandaag om] LT",
+			NextDay:  "[morgen om] LT",
+			NextWeek: "dddd [om] LT",
+			LastDay:  "[gisteren om] LT",
+			LastWeek: "[afgelopen] dddd [om] LT",
+			SameElse: "L",
+		},
+	})
+
+	// PortugueseLocale is the portuguese Locale with weeks as in Brazil
+	PortugueseLocale = cldrLocale("pt", Locale{
+		Ordinal:      suffixOrdinal("º"),
+		WeekCalendar: SundayWeekCalendar,
+		LocalizedFormats: LocalizedFormats{
+			LT:   "HH:mm",
+			LTS:  "HH:mm:ss",
+			L:    "DD/MM/YYYY",
+			LL:   "D [de] MMMM [de] YYYY",
+			LLL:  "D [de] MMMM [de] YYYY HH:mm",
+			LLLL: "dddd, D [de] MMMM [de] YYYY HH:mm",
+		},
+		RelativeTimeFormats: RelativeTimeFormats{
+			Future: "em %s", Past: "há %s",
+			FewSeconds: "poucos segundos", Seconds: "%d segundos",
+			Minute: "um minuto", Minutes: "%d minutos",
+			Hour: "uma hora", Hours: "%d horas",
+			Day: "um dia", Days: "%d dias",
+			Month: "um mês", Months: "%d meses",
+			Year: "um ano", Years: "%d anos",
+		},
+		CalendarFormats: CalendarFormats{
+			SameDay:  "[Hoje às] LT",
+			NextDay:  "[Amanhã às] LT",
+			NextWeek: "dddd [às] LT",
+			LastDay:  "[Ontem às] LT",
+			LastWeek: "dddd [da semana passada às] LT",
+			SameElse: "L",
+		},
+	})
+
+	// JapaneseLocale is the japanese Locale
+	JapaneseLocale = cldrLocale("ja", Locale{
+		Ordinal:      suffixOrdinal("日"),
+		WeekCalendar: SundayWeekCalendar,
+		LocalizedFormats: LocalizedFormats{
+			LT:   "HH:mm",
+			LTS:  "HH:mm:ss",
+			L:    "YYYY/MM/DD",
+			LL:   "YYYY年M月D日",
+			LLL:  "YYYY年M月D日 HH:mm",
+			LLLL: "YYYY年M月D日dddd HH:mm",
+		},
+		RelativeTimeFormats: RelativeTimeFormats{
+			Future: "%s後", Past: "%s前",
+			FewSeconds: "数秒", Seconds: "%d秒",
+			Minute: "1分", Minutes: "%d分",
+			Hour: "1時間", Hours: "%d時間",
+			Day: "1日", Days: "%d日",
+			Month: "1ヶ月", Months: "%dヶ月",
+			Year: "1年", Years: "%d年",
+		},
+		CalendarFormats: CalendarFormats{
+			SameDay:  "[今日] LT",
+			NextDay:  "[明日] LT",
+			NextWeek: "[来週]dddd LT",
+			LastDay:  "[昨日] LT",
+			LastWeek: "[先週]dddd LT",
+			SameElse: "L",
+		},
+	})
+
+	localesByLanguage = map[string]Locale{
+		"en": EnglishLocale,
+		"de": GermanLocale,
+		"fr": FrenchLocale,
+		"es": SpanishLocale,
+		"it": ItalianLocale,
+		"nl": DutchLocale,
+		"pt": PortugueseLocale,
+		"ja": JapaneseLocale,
+	}
+)
+
+// cldrLocale returns given Locale with the names and the date and time patterns of the cldrLocales
+// of given language code
+func cldrLocale(code string, locale Locale) Locale {
+	cldr := cldrLocales[code]
+	locale.Code = cldr.Code
+	locale.Months = cldr.Months
+	locale.MonthsShort = cldr.MonthsShort
+	locale.Weekdays = cldr.Weekdays
+	locale.WeekdaysShort = cldr.WeekdaysShort
+	locale.Meridiem = cldr.Meridiem
+	locale.DateFormats = cldr.DateFormats
+	locale.TimeFormats = cldr.TimeFormats
+	locale.DateTimeFormats = cldr.DateTimeFormats
+	return locale
+}
+
+// suffixOrdinal returns an Ordinal, which appends given suffix to the day
+func suffixOrdinal(suffix string) func(day int) string {
+	return func(day int) string {
+		return fmt.Sprint(day) + suffix
+	}
+}
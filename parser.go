@@ -0,0 +1,168 @@
+package gostradamus
+
+import "time"
+
+// ParseMode is the strictness of parsing
+type ParseMode int
+
+// All ParseModes
+const (
+	// ParseModeDefault parses like time.Parse: spaces match one or more spaces,
+	// names ignore the case, HH accepts one digit and a fractional second may follow the seconds
+	ParseModeDefault ParseMode = iota
+	// ParseModeStrict only accepts values as Format returns them: exact widths, spaces and case of names.
+	// The weekday has to match the date.
+	ParseModeStrict
+	// ParseModeLenient ignores leading, trailing and surrounding whitespace, the case of names and meridiem
+	// and accepts padded numbers without leading zeros, microseconds with fewer digits
+	// and offsets with or without colon
+	ParseModeLenient
+)
+
+// Parser parses values with a list of formats, which are compiled once and tried in order
+type Parser struct {
+	// Timezone of values without an offset, empty is the timezone of Reference or UTC without Reference
+	Timezone Timezone
+	// Mode is the strictness of parsing
+	Mode ParseMode
+	// Reference returns the DateTime, which fills the fields missing in a value, nil keeps them zero.
+	// Missing fields, which are more significant than the fields of the value, are taken from the reference,
+	// like the date of "14:30". Use Now or a func returning NowInTimezone to parse relative to the current day.
+	Reference func() DateTime
+
+	locale  Locale
+	formats []compiledFormat
+}
+
+// NewParser returns a new Parser, which tries given formats in order with the names of given Locale.
+// error is returned if a format contains a FormatToken, which is not parsable.
+//
+// For Example:
+//
+//	NewParser(EnglishLocale, "DD.MM.YYYY", "YYYY-MM-DD", "MM/DD/YY")
+func NewParser(locale Locale, formats ...string) (Parser, error) {
+	compiledFormats := make([]compiledFormat, len(formats))
+	for index, format := range formats {
+		compiled, err := compileFormat(format, locale)
+		if err != nil {
+			return Parser{}, err
+		}
+		compiledFormats[index] = compiled
+	}
+	return Parser{locale: locale, formats: compiledFormats}, nil
+}
+
+// MustNewParser is like NewParser, but panics if a format contains a FormatToken, which is not parsable.
+// It simplifies the initialization of global variables holding Parsers.
+func MustNewParser(locale Locale, formats ...string) Parser {
+	parser, err := NewParser(locale, formats...)
+	if err != nil {
+		panic(err)
+	}
+	return parser
+}
+
+// Locale returns the Locale of the names in the formats
+func (p Parser) Locale() Locale {
+	return p.locale
+}
+
+// Formats returns the formats in the order they are tried
+func (p Parser) Formats() []string {
+	formats := make([]string, len(p.formats))
+	for index, format := range p.formats {
+		formats[index] = format.format
+	}
+	return formats
+}
+
+// Parse a string value with the first matching format into a new DateTime.
+// error joins the errors of all formats if none matches
+func (p Parser) Parse(value string) (DateTime, error) {
+	partial, err := p.ParsePartial(value)
+	return partial.DateTime, err
+}
+
+// ParsePartial a string value with the first matching format into a new PartialDateTime,
+// which reports the Fields present in the value.
+// error joins the errors of all formats if none matches
+func (p Parser) ParsePartial(value string) (PartialDateTime, error) {
+	location, reference := p.locationAndReference()
+	formatErrors := make([]error, 0, len(p.formats))
+	for _, format := range p.formats {
+		parsedTime, fields, err := format.parsePartial(value, location, p.locale, p.Mode, reference)
+		if err == nil {
+			return PartialDateTime{DateTime: DateTimeFromTime(parsedTime), Fields: fields}, nil
+		}
+		formatErrors = append(formatErrors, err)
+	}
+	return PartialDateTime{}, ValueIsNotParsableAsAny(value, p.Formats(), formatErrors)
+}
+
+// parsePartialWithFormat parses a string value with given format instead of the formats of the Parser
+func (p Parser) parsePartialWithFormat(value string, format string) (PartialDateTime, error) {
+	compiled, err := compileFormat(format, p.locale)
+	if err != nil {
+		return PartialDateTime{}, err
+	}
+	location, reference := p.locationAndReference()
+	parsedTime, fields, err := compiled.parsePartial(value, location, p.locale, p.Mode, reference)
+	return PartialDateTime{DateTime: DateTimeFromTime(parsedTime), Fields: fields}, err
+}
+
+// locationAndReference returns the location of values without an offset and the time of Reference, if set
+func (p Parser) locationAndReference() (*time.Location, *time.Time) {
+	location := time.UTC
+	var reference *time.Time
+	if p.Reference != nil {
+		referenceTime := p.Reference().Time()
+		location, reference = referenceTime.Location(), &referenceTime
+	}
+	if p.Timezone != "" {
+		location = p.Timezone.Location()
+	}
+	return location, reference
+}
+
+// ParseFirst a string value with the first matching of given formats into a new DateTime.
+// error joins the errors of all formats if none matches
+//
+// For Example:
+//
+//	ParseFirst("2010-02-10", "DD.MM.YYYY", "YYYY-MM-DD") parses with "YYYY-MM-DD"
+func ParseFirst(value string, formats ...string) (DateTime, error) {
+	formatErrors := make([]error, 0, len(formats))
+	for _, format := range formats {
+		parsedTime, err := parseToTimeInLocale(value, format, UTC, defaultLocale())
+		if err == nil {
+			return DateTimeFromTime(parsedTime), nil
+		}
+		formatErrors = append(formatErrors, err)
+	}
+	return DateTime{}, ValueIsNotParsableAsAny(value, formats, formatErrors)
+}
+
+// ParseStrict a string value with given format into a new DateTime with ParseModeStrict
+//
+// For Example:
+//
+//	ParseStrict("Monday 2019-08-01", "dddd YYYY-MM-DD") fails, because 2019-08-01 is a thursday
+func ParseStrict(value string, format string) (DateTime, error) {
+	return parseInMode(value, format, ParseModeStrict)
+}
+
+// ParseLenient a string value with given format into a new DateTime with ParseModeLenient
+//
+// For Example:
+//
+//	ParseLenient(" 2023-2-3 ", "YYYY-MM-DD") becomes 2023-02-03
+func ParseLenient(value string, format string) (DateTime, error) {
+	return parseInMode(value, format, ParseModeLenient)
+}
+
+func parseInMode(value string, format string, mode ParseMode) (DateTime, error) {
+	parser := Parser{locale: defaultLocale()}
+	parser.Mode = mode
+	partial, err := parser.parsePartialWithFormat(value, format)
+	return partial.DateTime, err
+}
@@ -0,0 +1,171 @@
+package gostradamus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFirst(t *testing.T) {
+	formats := []string{"DD.MM.YYYY", "YYYY-MM-DD", "MM/DD/YY"}
+	tests := map[string]DateTime{
+		"10.02.2010": NewDateTime(2010, 2, 10, 0, 0, 0, 0, UTC),
+		"2010-02-10": NewDateTime(2010, 2, 10, 0, 0, 0, 0, UTC),
+		"02/10/10":   NewDateTime(2010, 2, 10, 0, 0, 0, 0, UTC),
+	}
+	for value, expected := range tests {
+		actual, err := ParseFirst(value, formats...)
+		assert.NoError(t, err, value)
+		assert.Equal(t, expected, actual, value)
+	}
+}
+
+func TestParseFirst_Error(t *testing.T) {
+	_, err := ParseFirst("2010-13-10", "DD.MM.YYYY", "YYYY-MM-DD", "ww")
+	assert.EqualError(
+		t,
+		err,
+		`Value: "2010-13-10" is not parsable as any of ["DD.MM.YYYY" "YYYY-MM-DD" "ww"]`+"\n"+
+			`Value: "2010-13-10" is not parsable as "DD.MM.YYYY", "10-13-10" does not match "."`+"\n"+
+			`Value: "2010-13-10" is not parsable as "YYYY-MM-DD", month 13 is out of range`+"\n"+
+			`FormatToken: ww is not parsable`,
+	)
+
+	_, err = ParseFirst("2010-02-10")
+	assert.EqualError(t, err, `Value: "2010-02-10" is not parsable as any of []`)
+}
+
+func TestNewParser(t *testing.T) {
+	parser, err := NewParser(GermanLocale, "DD.MM.YYYY", "D. MMMM YYYY")
+	assert.NoError(t, err)
+	assert.Equal(t, GermanLocale.Code, parser.Locale().Code)
+	assert.Equal(t, []string{"DD.MM.YYYY", "D. MMMM YYYY"}, parser.Formats())
+	assert.Equal(t, Timezone(""), parser.Timezone)
+	assert.Equal(t, ParseModeDefault, parser.Mode)
+
+	_, err = NewParser(EnglishLocale, "DD.MM.YYYY", "ww")
+	assert.EqualError(t, err, "FormatToken: ww is not parsable")
+}
+
+func TestMustNewParser(t *testing.T) {
+	parser := MustNewParser(EnglishLocale, "YYYY-MM-DD")
+	assert.Equal(t, []string{"YYYY-MM-DD"}, parser.Formats())
+
+	assert.PanicsWithError(t, "FormatToken: ww is not parsable", func() {
+		MustNewParser(EnglishLocale, "ww")
+	})
+}
+
+func TestParser_Parse(t *testing.T) {
+	parser := MustNewParser(GermanLocale, "DD.MM.YYYY HH:mm", "D. MMMM YYYY")
+	actual, err := parser.Parse("5. März 2011")
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2011, 3, 5, 0, 0, 0, 0, UTC), actual)
+
+	parser.Timezone = EuropeBerlin
+	actual, err = parser.Parse("05.03.2011 15:07")
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2011, 3, 5, 15, 7, 0, 0, EuropeBerlin), actual)
+
+	_, err = parser.Parse("5. May 2011")
+	assert.EqualError(
+		t,
+		err,
+		`Value: "5. May 2011" is not parsable as any of ["DD.MM.YYYY HH:mm" "D. MMMM YYYY"]`+"\n"+
+			`Value: "5. May 2011" is not parsable as "DD.MM.YYYY HH:mm", "5. May 2011" does not match DD`+"\n"+
+			`Value: "5. May 2011" is not parsable as "D. MMMM YYYY", "May 2011" does not match MMMM`,
+	)
+}
+
+func TestParser_Parse_Strict(t *testing.T) {
+	parser := MustNewParser(EnglishLocale, "MMMM D, YYYY HH:mm")
+	_, err := parser.Parse("april 5,  2011 9:07")
+	assert.NoError(t, err)
+
+	parser.Mode = ParseModeStrict
+	for _, value := range []string{"april 5, 2011 09:07", "April 5,  2011 09:07", "April 5, 2011 9:07"} {
+		_, err = parser.Parse(value)
+		assert.Error(t, err, value)
+	}
+	actual, err := parser.Parse("April 5, 2011 09:07")
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2011, 4, 5, 9, 7, 0, 0, UTC), actual)
+}
+
+func TestParseStrict(t *testing.T) {
+	actual, err := ParseStrict("Thursday 2019-08-01 09:07", "dddd YYYY-MM-DD HH:mm")
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2019, 8, 1, 9, 7, 0, 0, UTC), actual)
+
+	actual, err = ParseStrict("1.8.2019 9:07 PM", "D.M.YYYY h:mm A")
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2019, 8, 1, 21, 7, 0, 0, UTC), actual)
+
+	_, err = ParseStrict("Monday 2019-08-01 09:07", "dddd YYYY-MM-DD HH:mm")
+	assert.EqualError(
+		t,
+		err,
+		`Value: "Monday 2019-08-01 09:07" is not parsable as "dddd YYYY-MM-DD HH:mm", weekday Monday does not match 2019-08-01`,
+	)
+
+	for _, value := range []string{
+		"01.8.2019 9:07 PM",
+		"1.08.2019 9:07 PM",
+		"1.8.2019 09:07 PM",
+		"1.8.2019 9:07 pm",
+		"1.8.2019  9:07 PM",
+		"1.8.2019 9:07 PM ",
+		" 1.8.2019 9:07 PM",
+	} {
+		_, err = ParseStrict(value, "D.M.YYYY h:mm A")
+		assert.Error(t, err, value)
+	}
+
+	_, err = ParseStrict("2019-08-01 9:07", "YYYY-MM-DD HH:mm")
+	assert.Error(t, err)
+}
+
+func TestParseLenient(t *testing.T) {
+	tests := map[string]string{
+		" 2023-2-3 ":         "YYYY-MM-DD",
+		"2023 - 02 - 03":     "YYYY-MM-DD",
+		"2023-2-3\t1:2:3":    "YYYY-MM-DD HH:mm:ss",
+		"3. FEBRUARY  2023":  "D. MMMM YYYY",
+		"friday, feb 3 2023": "dddd, MMM D YYYY",
+		"2023-02-03 1:02 am": "YYYY-MM-DD hh:mm A",
+	}
+	for value, format := range tests {
+		actual, err := ParseLenient(value, format)
+		assert.NoError(t, err, value)
+		assert.Equal(t, 2023, actual.Year(), value)
+		assert.Equal(t, 2, actual.Month(), value)
+		assert.Equal(t, 3, actual.Day(), value)
+	}
+
+	actual, err := ParseLenient("2023-2-3 1:2:3", "YYYY-MM-DD HH:mm:ss")
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2023, 2, 3, 1, 2, 3, 0, UTC), actual)
+
+	// the weekday is not checked
+	_, err = ParseLenient("monday 2023-02-03", "dddd YYYY-MM-DD")
+	assert.NoError(t, err)
+
+	for _, value := range []string{"2023-2-3 x", "23-2-3", "2023-123-3"} {
+		_, err = ParseLenient(value, "YYYY-MM-DD")
+		assert.Error(t, err, value)
+	}
+
+	_, err = ParseLenient("2023 ww", "YYYY ww")
+	assert.EqualError(t, err, "FormatToken: ww is not parsable")
+}
+
+func TestParser_Parse_Lenient(t *testing.T) {
+	parser := MustNewParser(EnglishLocale, "DD.MM.YYYY", "YYYY-MM-DD")
+	_, err := parser.Parse(" 3.2.2023")
+	assert.Error(t, err)
+
+	parser.Mode = ParseModeLenient
+	actual, err := parser.Parse(" 3.2.2023")
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2023, 2, 3, 0, 0, 0, 0, UTC), actual)
+}
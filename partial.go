@@ -0,0 +1,66 @@
+package gostradamus
+
+// Field is a field of a DateTime, which can be present in a parsed value.
+// Fields can be combined, like FieldYear | FieldMonth.
+type Field int
+
+// All Fields
+const (
+	FieldYear Field = 1 << iota
+	FieldMonth
+	FieldDay
+	FieldWeekday
+	FieldHour
+	FieldMinute
+	FieldSecond
+	FieldNanosecond
+	// FieldTimezone is present if the value has an offset or a timezone abbreviation
+	FieldTimezone
+)
+
+// FieldDate combines the Fields of a date
+const FieldDate = FieldYear | FieldMonth | FieldDay
+
+// FieldTime combines the Fields of a time
+const FieldTime = FieldHour | FieldMinute | FieldSecond
+
+// PartialDateTime is a parsed DateTime with the Fields, which were present in the value
+type PartialDateTime struct {
+	DateTime DateTime
+	Fields   Field
+}
+
+// Has checks if all given Fields were present in the value
+//
+// For Example:
+//
+//	"14:30" parsed with "HH:mm" has FieldHour | FieldMinute, but not FieldDate
+func (p PartialDateTime) Has(fields Field) bool {
+	return p.Fields&fields == fields
+}
+
+// ParsePartial a string value with given format into a new PartialDateTime,
+// which reports the Fields present in the value. Missing fields are zero or, if zero is impossible, one.
+func ParsePartial(value string, format string) (PartialDateTime, error) {
+	return Parser{locale: defaultLocale()}.parsePartialWithFormat(value, format)
+}
+
+// ParseWithReference a string value with given format into a new DateTime in the timezone of reference.
+// Missing fields, which are more significant than the fields of the value, are taken from reference.
+// Less significant missing fields are zero.
+// A weekday without date is the day in the week of reference,
+// which starts on the first day of the DefaultWeekCalendar.
+//
+// For Example with reference 2020-01-15 12:30:00 (wednesday):
+//
+//	"14:30" parsed with "HH:mm" becomes 2020-01-15 14:30:00
+//	"March 5" parsed with "MMMM D" becomes 2020-03-05 00:00:00
+//	"Friday" parsed with "dddd" becomes 2020-01-17 00:00:00
+func ParseWithReference(value string, format string, reference DateTime) (DateTime, error) {
+	parser := Parser{locale: defaultLocale()}
+	parser.Reference = func() DateTime {
+		return reference
+	}
+	partial, err := parser.parsePartialWithFormat(value, format)
+	return partial.DateTime, err
+}
@@ -0,0 +1,106 @@
+package gostradamus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePartial(t *testing.T) {
+	tests := []struct {
+		value    string
+		format   string
+		expected Field
+	}{
+		{"14:30", "HH:mm", FieldHour | FieldMinute},
+		{"2:30 PM", "h:mm A", FieldHour | FieldMinute},
+		{"March 5", "MMMM D", FieldMonth | FieldDay},
+		{"Thursday 1st August", "dddd Do MMMM", FieldWeekday | FieldMonth | FieldDay},
+		{"2020-065", "YYYY-DDDD", FieldDate},
+		{"2020-03-05T14:30:15.000001+01:00", "YYYY-MM-DDTHH:mm:ss.Szz", FieldDate | FieldTime | FieldNanosecond | FieldTimezone},
+		{"2020", "YYYY", FieldYear},
+	}
+	for _, test := range tests {
+		actual, err := ParsePartial(test.value, test.format)
+		assert.NoError(t, err, test.value)
+		assert.Equal(t, test.expected, actual.Fields, test.value)
+	}
+
+	actual, err := ParsePartial("14:30", "HH:mm")
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(0, 1, 1, 14, 30, 0, 0, UTC), actual.DateTime)
+	assert.True(t, actual.Has(FieldHour|FieldMinute))
+	assert.False(t, actual.Has(FieldTime))
+	assert.False(t, actual.Has(FieldDay))
+
+	_, err = ParsePartial("14:30", "HH:mm ww")
+	assert.EqualError(t, err, "FormatToken: ww is not parsable")
+}
+
+func TestParseWithReference(t *testing.T) {
+	reference := NewDateTime(2020, 1, 15, 12, 30, 45, 500, EuropeBerlin)
+	tests := []struct {
+		value    string
+		format   string
+		expected DateTime
+	}{
+		{"14:30", "HH:mm", NewDateTime(2020, 1, 15, 14, 30, 0, 0, EuropeBerlin)},
+		{"2:30 PM", "h:mm A", NewDateTime(2020, 1, 15, 14, 30, 0, 0, EuropeBerlin)},
+		{"15", "ss", NewDateTime(2020, 1, 15, 12, 30, 15, 0, EuropeBerlin)},
+		{"March 5", "MMMM D", NewDateTime(2020, 3, 5, 0, 0, 0, 0, EuropeBerlin)},
+		{"20", "DD", NewDateTime(2020, 1, 20, 0, 0, 0, 0, EuropeBerlin)},
+		{"065", "DDDD", NewDateTime(2020, 3, 5, 0, 0, 0, 0, EuropeBerlin)},
+		{"2019", "YYYY", NewDateTime(2019, 1, 1, 0, 0, 0, 0, EuropeBerlin)},
+		{"Wednesday", "dddd", NewDateTime(2020, 1, 15, 0, 0, 0, 0, EuropeBerlin)},
+		{"Friday", "dddd", NewDateTime(2020, 1, 17, 0, 0, 0, 0, EuropeBerlin)},
+		{"Mon", "ddd", NewDateTime(2020, 1, 13, 0, 0, 0, 0, EuropeBerlin)},
+		{"Sunday 14:30", "dddd HH:mm", NewDateTime(2020, 1, 19, 14, 30, 0, 0, EuropeBerlin)},
+		{"Friday 2020-01-17", "dddd YYYY-MM-DD", NewDateTime(2020, 1, 17, 0, 0, 0, 0, EuropeBerlin)},
+		{"2019-06-01 10:00", "YYYY-MM-DD HH:mm", NewDateTime(2019, 6, 1, 10, 0, 0, 0, EuropeBerlin)},
+	}
+	for _, test := range tests {
+		actual, err := ParseWithReference(test.value, test.format, reference)
+		assert.NoError(t, err, test.value)
+		assert.Equal(t, test.expected, actual, test.value)
+	}
+
+	// the date of the reference is taken in the timezone of the reference
+	actual, err := ParseWithReference("00:30", "HH:mm", NewDateTime(2020, 1, 15, 23, 30, 0, 0, UTC).InTimezone(EuropeBerlin))
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2020, 1, 16, 0, 30, 0, 0, EuropeBerlin), actual)
+
+	// the week starts on the first day of the DefaultWeekCalendar
+	SetDefaultWeekCalendar(SundayWeekCalendar)
+	defer SetDefaultWeekCalendar(IsoWeekCalendar)
+	actual, err = ParseWithReference("Sunday", "dddd", reference)
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2020, 1, 12, 0, 0, 0, 0, EuropeBerlin), actual)
+
+	_, err = ParseWithReference("30", "DD", reference.ShiftMonths(1))
+	assert.EqualError(t, err, `Value: "30" is not parsable as "DD", day 30 is out of range`)
+}
+
+func TestParser_Reference(t *testing.T) {
+	parser := MustNewParser(EnglishLocale, "YYYY-MM-DD HH:mm", "HH:mm")
+	parser.Reference = func() DateTime {
+		return NewDateTime(2020, 1, 15, 12, 30, 0, 0, AsiaKathmandu)
+	}
+
+	actual, err := parser.Parse("14:30")
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2020, 1, 15, 14, 30, 0, 0, AsiaKathmandu), actual)
+
+	partial, err := parser.ParsePartial("2019-06-01 10:00")
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2019, 6, 1, 10, 0, 0, 0, AsiaKathmandu), partial.DateTime)
+	assert.Equal(t, FieldDate|FieldHour|FieldMinute, partial.Fields)
+
+	parser.Timezone = EuropeBerlin
+	actual, err = parser.Parse("14:30")
+	assert.NoError(t, err)
+	// 2020-01-15 12:30 in Kathmandu is 2020-01-15 07:45 in Berlin
+	assert.Equal(t, NewDateTime(2020, 1, 15, 14, 30, 0, 0, EuropeBerlin), actual)
+
+	_, err = parser.ParsePartial("later")
+	assert.Error(t, err)
+}
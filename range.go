@@ -0,0 +1,132 @@
+package gostradamus
+
+import (
+	"iter"
+	"slices"
+	"time"
+)
+
+// Range returns an iterator over the DateTimes from start to end, both included, which are step units apart.
+//
+// Every DateTime is shifted from start directly, so month ends are kept:
+// a monthly Range from 2012-01-31 yields 2012-01-31, 2012-02-29, 2012-03-31 and so on.
+// Day and larger units keep the wall clock across daylight saving time transitions,
+// smaller units count the elapsed time.
+//
+// Range panics if step is not positive or the unit is none of the Units from UnitNanosecond to UnitCentury.
+// Both are checked when Range is called, not when the iterator is used.
+func Range(start DateTime, end DateTime, unit Unit, step int) iter.Seq[DateTime] {
+	if step < 1 {
+		panic(StepIsNotPositive(step))
+	}
+	if _, ok := unitNames[unit]; !ok {
+		panic(UnitIsNotSupported(unit))
+	}
+
+	return func(yield func(DateTime) bool) {
+		for i := 0; ; i++ {
+			current := start.shiftByClamped(unit, i*step)
+			if current.IsAfter(end) || !yield(current) {
+				return
+			}
+		}
+	}
+}
+
+// RangeSlice returns the DateTimes of Range as slice
+//
+// RangeSlice panics if step is not positive or the unit is none of the Units from UnitNanosecond to UnitCentury
+func RangeSlice(start DateTime, end DateTime, unit Unit, step int) []DateTime {
+	return slices.Collect(Range(start, end, unit, step))
+}
+
+// SpanRange returns an iterator over the consecutive span Intervals of given unit,
+// from the span containing start to the span containing end.
+// Week spans start on the first day of the DefaultWeekCalendar, use WeekCalendar.SpanRange for another WeekCalendar.
+//
+// For Example:
+//
+//	SpanRange(2012-12-12, 2012-12-25, UnitWeek) yields the weeks starting on 2012-12-10, 2012-12-17 and 2012-12-24
+//
+// SpanRange panics if the unit is not supported
+func SpanRange(start DateTime, end DateTime, unit Unit) iter.Seq[Interval] {
+	return spanRange(start, end, func(dateTime DateTime) Interval {
+		return dateTime.SpanInterval(unit)
+	})
+}
+
+// SpanRangeSlice returns the Intervals of SpanRange as slice
+//
+// SpanRangeSlice panics if the unit is not supported
+func SpanRangeSlice(start DateTime, end DateTime, unit Unit) []Interval {
+	return slices.Collect(SpanRange(start, end, unit))
+}
+
+// SpanRange returns an iterator over the consecutive weeks of this WeekCalendar as Intervals,
+// from the week containing start to the week containing end
+//
+// For Example with SundayWeekCalendar:
+//
+//	SpanRange(2012-12-12, 2012-12-16) yields the weeks starting on 2012-12-09 and 2012-12-16
+func (wc WeekCalendar) SpanRange(start DateTime, end DateTime) iter.Seq[Interval] {
+	return spanRange(start, end, func(dateTime DateTime) Interval {
+		return IntervalFromSpan(dateTime.SpanWeekStartingOn(wc.FirstDay))
+	})
+}
+
+// SpanRangeSlice returns the Intervals of WeekCalendar.SpanRange as slice
+func (wc WeekCalendar) SpanRangeSlice(start DateTime, end DateTime) []Interval {
+	return slices.Collect(wc.SpanRange(start, end))
+}
+
+// spanRange returns an iterator over the Intervals returned by span,
+// from the Interval containing start to the Interval containing end
+func spanRange(start DateTime, end DateTime, span func(DateTime) Interval) iter.Seq[Interval] {
+	first := span(start)
+
+	return func(yield func(Interval) bool) {
+		for current := first; !current.Start.IsAfter(end); current = span(current.End) {
+			if !yield(current) {
+				return
+			}
+		}
+	}
+}
+
+// shiftByClamped works like ShiftBy, but month based units keep the day of month
+// or use the last day of the month, if the month is too short
+func (dt DateTime) shiftByClamped(unit Unit, amount int) DateTime {
+	var months int
+	switch unit {
+	case UnitMonth:
+		months = amount
+	case UnitQuarter:
+		months = amount * 3
+	case UnitHalfYear:
+		months = amount * 6
+	case UnitYear:
+		months = amount * 12
+	case UnitDecade:
+		months = amount * 120
+	case UnitCentury:
+		months = amount * 1200
+	default:
+		return dt.ShiftBy(unit, amount)
+	}
+
+	t := dt.Time()
+	firstOfMonth := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	lastDayOfMonth := firstOfMonth.AddDate(0, 1, -1).Day()
+	return DateTimeFromTime(
+		time.Date(
+			firstOfMonth.Year(),
+			firstOfMonth.Month(),
+			min(t.Day(), lastDayOfMonth),
+			t.Hour(),
+			t.Minute(),
+			t.Second(),
+			t.Nanosecond(),
+			t.Location(),
+		),
+	)
+}
@@ -0,0 +1,198 @@
+package gostradamus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRange(t *testing.T) {
+	var actual []DateTime
+	for dateTime := range Range(
+		NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0),
+		NewUTCDateTime(2020, 1, 1, 1, 0, 0, 0),
+		UnitMinute,
+		20,
+	) {
+		actual = append(actual, dateTime)
+	}
+	assert.Equal(
+		t,
+		[]DateTime{
+			NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0),
+			NewUTCDateTime(2020, 1, 1, 0, 20, 0, 0),
+			NewUTCDateTime(2020, 1, 1, 0, 40, 0, 0),
+			NewUTCDateTime(2020, 1, 1, 1, 0, 0, 0),
+		},
+		actual,
+	)
+
+	// Stops when the loop breaks
+	actual = nil
+	for dateTime := range Range(NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0), NewUTCDateTime(2030, 1, 1, 0, 0, 0, 0), UnitDay, 1) {
+		if len(actual) == 2 {
+			break
+		}
+		actual = append(actual, dateTime)
+	}
+	assert.Len(t, actual, 2)
+
+	assert.PanicsWithError(
+		t,
+		"Step: 0 is not positive",
+		func() {
+			Range(NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0), NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0), UnitDay, 0)
+		},
+	)
+	assert.PanicsWithError(
+		t,
+		"Unit: 42 is not supported",
+		func() {
+			Range(NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0), NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0), Unit(42), 1)
+		},
+	)
+	assert.PanicsWithError(
+		t,
+		"Unit: -1 is not supported",
+		func() {
+			Range(NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0), NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0), Unit(-1), 1)
+		},
+	)
+}
+
+func TestRangeSlice(t *testing.T) {
+	// Month ends are kept
+	actual := RangeSlice(NewUTCDateTime(2020, 1, 31, 12, 0, 0, 0), NewUTCDateTime(2020, 5, 31, 12, 0, 0, 0), UnitMonth, 1)
+	assert.Equal(
+		t,
+		[]DateTime{
+			NewUTCDateTime(2020, 1, 31, 12, 0, 0, 0),
+			NewUTCDateTime(2020, 2, 29, 12, 0, 0, 0),
+			NewUTCDateTime(2020, 3, 31, 12, 0, 0, 0),
+			NewUTCDateTime(2020, 4, 30, 12, 0, 0, 0),
+			NewUTCDateTime(2020, 5, 31, 12, 0, 0, 0),
+		},
+		actual,
+	)
+
+	actual = RangeSlice(NewUTCDateTime(2020, 2, 29, 0, 0, 0, 0), NewUTCDateTime(2024, 3, 1, 0, 0, 0, 0), UnitYear, 2)
+	assert.Equal(
+		t,
+		[]DateTime{
+			NewUTCDateTime(2020, 2, 29, 0, 0, 0, 0),
+			NewUTCDateTime(2022, 2, 28, 0, 0, 0, 0),
+			NewUTCDateTime(2024, 2, 29, 0, 0, 0, 0),
+		},
+		actual,
+	)
+
+	// Days keep the wall clock across daylight saving time
+	actual = RangeSlice(
+		NewDateTime(2020, 3, 28, 12, 0, 0, 0, EuropeBerlin),
+		NewDateTime(2020, 3, 30, 12, 0, 0, 0, EuropeBerlin),
+		UnitDay,
+		1,
+	)
+	assert.Equal(
+		t,
+		[]DateTime{
+			NewDateTime(2020, 3, 28, 12, 0, 0, 0, EuropeBerlin),
+			NewDateTime(2020, 3, 29, 12, 0, 0, 0, EuropeBerlin),
+			NewDateTime(2020, 3, 30, 12, 0, 0, 0, EuropeBerlin),
+		},
+		actual,
+	)
+
+	// Hours count the elapsed time across daylight saving time
+	actual = RangeSlice(
+		NewDateTime(2020, 3, 29, 1, 0, 0, 0, EuropeBerlin),
+		NewDateTime(2020, 3, 29, 4, 0, 0, 0, EuropeBerlin),
+		UnitHour,
+		1,
+	)
+	assert.Equal(
+		t,
+		[]DateTime{
+			NewDateTime(2020, 3, 29, 1, 0, 0, 0, EuropeBerlin),
+			NewDateTime(2020, 3, 29, 3, 0, 0, 0, EuropeBerlin),
+			NewDateTime(2020, 3, 29, 4, 0, 0, 0, EuropeBerlin),
+		},
+		actual,
+	)
+
+	assert.Empty(t, RangeSlice(NewUTCDateTime(2020, 1, 2, 0, 0, 0, 0), NewUTCDateTime(2020, 1, 1, 0, 0, 0, 0), UnitDay, 1))
+}
+
+func TestSpanRange(t *testing.T) {
+	var actual []Interval
+	for span := range SpanRange(NewUTCDateTime(2012, 12, 12, 0, 0, 0, 0), NewUTCDateTime(2012, 12, 25, 0, 0, 0, 0), UnitWeek) {
+		actual = append(actual, span)
+	}
+	assert.Equal(
+		t,
+		[]Interval{
+			NewInterval(NewUTCDateTime(2012, 12, 10, 0, 0, 0, 0), NewUTCDateTime(2012, 12, 17, 0, 0, 0, 0)),
+			NewInterval(NewUTCDateTime(2012, 12, 17, 0, 0, 0, 0), NewUTCDateTime(2012, 12, 24, 0, 0, 0, 0)),
+			NewInterval(NewUTCDateTime(2012, 12, 24, 0, 0, 0, 0), NewUTCDateTime(2012, 12, 31, 0, 0, 0, 0)),
+		},
+		actual,
+	)
+}
+
+func TestSpanRangeSlice(t *testing.T) {
+	SetDefaultWeekCalendar(SundayWeekCalendar)
+	defer SetDefaultWeekCalendar(IsoWeekCalendar)
+
+	actual := SpanRangeSlice(NewUTCDateTime(2012, 12, 12, 0, 0, 0, 0), NewUTCDateTime(2012, 12, 16, 0, 0, 0, 0), UnitWeek)
+	assert.Equal(
+		t,
+		[]Interval{
+			NewInterval(NewUTCDateTime(2012, 12, 9, 0, 0, 0, 0), NewUTCDateTime(2012, 12, 16, 0, 0, 0, 0)),
+			NewInterval(NewUTCDateTime(2012, 12, 16, 0, 0, 0, 0), NewUTCDateTime(2012, 12, 23, 0, 0, 0, 0)),
+		},
+		actual,
+	)
+
+	// Days around daylight saving time are 23 and 25 hours long
+	spans := SpanRangeSlice(
+		NewDateTime(2020, 3, 29, 12, 0, 0, 0, EuropeBerlin),
+		NewDateTime(2020, 3, 29, 12, 0, 0, 0, EuropeBerlin),
+		UnitDay,
+	)
+	assert.Len(t, spans, 1)
+	assert.Equal(t, 23*time.Hour, spans[0].Duration())
+}
+
+func TestWeekCalendar_SpanRange(t *testing.T) {
+	var actual []Interval
+	for span := range SundayWeekCalendar.SpanRange(NewUTCDateTime(2012, 12, 12, 0, 0, 0, 0), NewUTCDateTime(2012, 12, 16, 0, 0, 0, 0)) {
+		actual = append(actual, span)
+	}
+	assert.Equal(
+		t,
+		[]Interval{
+			NewInterval(NewUTCDateTime(2012, 12, 9, 0, 0, 0, 0), NewUTCDateTime(2012, 12, 16, 0, 0, 0, 0)),
+			NewInterval(NewUTCDateTime(2012, 12, 16, 0, 0, 0, 0), NewUTCDateTime(2012, 12, 23, 0, 0, 0, 0)),
+		},
+		actual,
+	)
+}
+
+func TestWeekCalendar_SpanRangeSlice(t *testing.T) {
+	// the DefaultWeekCalendar is ignored
+	SetDefaultWeekCalendar(SundayWeekCalendar)
+	defer SetDefaultWeekCalendar(IsoWeekCalendar)
+
+	actual := SaturdayWeekCalendar.SpanRangeSlice(NewUTCDateTime(2012, 12, 12, 0, 0, 0, 0), NewUTCDateTime(2012, 12, 15, 0, 0, 0, 0))
+	assert.Equal(
+		t,
+		[]Interval{
+			NewInterval(NewUTCDateTime(2012, 12, 8, 0, 0, 0, 0), NewUTCDateTime(2012, 12, 15, 0, 0, 0, 0)),
+			NewInterval(NewUTCDateTime(2012, 12, 15, 0, 0, 0, 0), NewUTCDateTime(2012, 12, 22, 0, 0, 0, 0)),
+		},
+		actual,
+	)
+
+	assert.Empty(t, IsoWeekCalendar.SpanRangeSlice(NewUTCDateTime(2012, 12, 20, 0, 0, 0, 0), NewUTCDateTime(2012, 12, 12, 0, 0, 0, 0)))
+}
@@ -0,0 +1,249 @@
+package gostradamus
+
+import (
+	"iter"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Recurrence is a recurrence set of RFC 5545, which combines the occurrences of RRules
+// starting at Start with the additional RDates and removes the ExDates.
+// Start itself is only an occurrence, if it matches one of the Rules or is one of the RDates.
+// Occurrences are in the timezone of Start and keep its wall clock across daylight saving time transitions.
+type Recurrence struct {
+	Start   DateTime
+	Rules   []RRule
+	RDates  []DateTime
+	ExDates []DateTime
+}
+
+// NewRecurrence returns a new Recurrence of given RRules starting at start
+func NewRecurrence(start DateTime, rules ...RRule) Recurrence {
+	return Recurrence{Start: start, Rules: rules}
+}
+
+// ParseRecurrence parses the DTSTART, RRULE, RDATE and EXDATE lines of an iCalendar event into a new Recurrence.
+// DTSTART is required and DateTimes without "Z" or TZID parameter are parsed in UTC.
+//
+// For Example:
+//
+//	DTSTART;TZID=Europe/Berlin:20121212T090000
+//	RRULE:FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10
+//	EXDATE;TZID=Europe/Berlin:20121219T090000
+func ParseRecurrence(value string) (Recurrence, error) {
+	var recurrence Recurrence
+	var ruleLines []string
+	startLocation := time.UTC
+	hasStart := false
+
+	for _, line := range unfoldICalendarLines(value) {
+		name, lineValue, found := strings.Cut(line, ":")
+		if !found {
+			return Recurrence{}, RecurrenceIsNotParsable(line)
+		}
+		property, parameters, _ := strings.Cut(name, ";")
+		location, err := parseICalendarLocation(parameters)
+		if err != nil {
+			return Recurrence{}, RecurrenceIsNotParsable(line)
+		}
+
+		switch strings.ToUpper(property) {
+		case "DTSTART":
+			recurrence.Start, err = parseICalendarDateTime(lineValue, location)
+			startLocation = location
+			hasStart = true
+		case "RRULE":
+			ruleLines = append(ruleLines, lineValue)
+		case "RDATE":
+			var rDates []DateTime
+			rDates, err = parseICalendarDateTimes(lineValue, location)
+			recurrence.RDates = append(recurrence.RDates, rDates...)
+		case "EXDATE":
+			var exDates []DateTime
+			exDates, err = parseICalendarDateTimes(lineValue, location)
+			recurrence.ExDates = append(recurrence.ExDates, exDates...)
+		default:
+			return Recurrence{}, RecurrenceIsNotParsable(line)
+		}
+		if err != nil {
+			return Recurrence{}, RecurrenceIsNotParsable(line)
+		}
+	}
+	if !hasStart {
+		return Recurrence{}, RecurrenceIsNotParsable(value)
+	}
+
+	// UNTIL without "Z" is in the timezone of DTSTART, which can be defined after the RRULE
+	for _, ruleLine := range ruleLines {
+		rule, err := ParseRRuleInTimezone(ruleLine, Timezone(startLocation.String()))
+		if err != nil {
+			return Recurrence{}, err
+		}
+		recurrence.Rules = append(recurrence.Rules, rule)
+	}
+	return recurrence, nil
+}
+
+// String returns the Recurrence as iCalendar lines separated by "\n"
+// Example: "DTSTART;TZID=Europe/Berlin:20121212T090000\nRRULE:FREQ=DAILY;COUNT=3"
+func (r Recurrence) String() string {
+	location := r.Start.Time().Location()
+	parameter, layout := iCalendarLocationParameter(location)
+	if layout == iCalendarDateTimeUTCLayout {
+		location = time.UTC
+	}
+	format := func(dateTimes []DateTime) string {
+		formatted := make([]string, len(dateTimes))
+		for i, dateTime := range dateTimes {
+			formatted[i] = dateTime.Time().In(location).Format(layout)
+		}
+		return strings.Join(formatted, ",")
+	}
+
+	lines := []string{"DTSTART" + parameter + ":" + r.Start.Time().In(location).Format(layout)}
+	for _, rule := range r.Rules {
+		lines = append(lines, "RRULE:"+rule.String())
+	}
+	if len(r.RDates) > 0 {
+		lines = append(lines, "RDATE"+parameter+":"+format(r.RDates))
+	}
+	if len(r.ExDates) > 0 {
+		lines = append(lines, "EXDATE"+parameter+":"+format(r.ExDates))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// All returns an iterator over all occurrences of the Recurrence in chronological order.
+// The iterator never ends for Rules without Count or Until.
+func (r Recurrence) All() iter.Seq[DateTime] {
+	return func(yield func(DateTime) bool) {
+		excluded := make(map[int64]bool, len(r.ExDates))
+		for _, exDate := range r.ExDates {
+			excluded[exDate.Time().UnixNano()] = true
+		}
+
+		rDates := make([]DateTime, len(r.RDates))
+		for i, rDate := range r.RDates {
+			rDates[i] = DateTimeFromTime(rDate.Time().In(r.Start.Time().Location()))
+		}
+		Sort(rDates)
+
+		sources := []iter.Seq[DateTime]{slices.Values(rDates)}
+		for _, rule := range r.Rules {
+			sources = append(sources, func(yield func(DateTime) bool) {
+				rule.occurrences(r.Start, yield)
+			})
+		}
+
+		nexts := make([]func() (DateTime, bool), len(sources))
+		heads := make([]DateTime, len(sources))
+		valid := make([]bool, len(sources))
+		for i, source := range sources {
+			next, stop := iter.Pull(source)
+			defer stop()
+			nexts[i] = next
+			heads[i], valid[i] = next()
+		}
+
+		var last DateTime
+		hasLast := false
+		for {
+			index := -1
+			for i := range heads {
+				if valid[i] && (index < 0 || heads[i].IsBefore(heads[index])) {
+					index = i
+				}
+			}
+			if index < 0 {
+				return
+			}
+
+			current := heads[index]
+			heads[index], valid[index] = nexts[index]()
+			if (hasLast && current.Equal(last)) || excluded[current.Time().UnixNano()] {
+				continue
+			}
+			last, hasLast = current, true
+			if !yield(current) {
+				return
+			}
+		}
+	}
+}
+
+// Between returns all occurrences from start to end, both included
+func (r Recurrence) Between(start DateTime, end DateTime) []DateTime {
+	var occurrences []DateTime
+	for occurrence := range r.All() {
+		if occurrence.IsAfter(end) {
+			break
+		}
+		if !occurrence.IsBefore(start) {
+			occurrences = append(occurrences, occurrence)
+		}
+	}
+	return occurrences
+}
+
+// Next returns the first occurrence after given DateTime
+// The returned bool is false if there is no further occurrence
+func (r Recurrence) Next(after DateTime) (DateTime, bool) {
+	for occurrence := range r.All() {
+		if occurrence.IsAfter(after) {
+			return occurrence, true
+		}
+	}
+	return DateTime{}, false
+}
+
+// unfoldICalendarLines returns the non empty lines of value,
+// lines starting with a space or a tab continue the previous line
+func unfoldICalendarLines(value string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(value, "\r\n", "\n"), "\n") {
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// parseICalendarLocation returns the location of the TZID parameter or UTC
+func parseICalendarLocation(parameters string) (*time.Location, error) {
+	for _, parameter := range strings.Split(parameters, ";") {
+		name, value, _ := strings.Cut(parameter, "=")
+		if strings.EqualFold(name, "TZID") {
+			return LoadLocation(value)
+		}
+	}
+	return time.UTC, nil
+}
+
+func parseICalendarDateTimes(value string, location *time.Location) ([]DateTime, error) {
+	var dateTimes []DateTime
+	for _, item := range strings.Split(value, ",") {
+		dateTime, err := parseICalendarDateTime(item, location)
+		if err != nil {
+			return nil, err
+		}
+		dateTimes = append(dateTimes, dateTime)
+	}
+	return dateTimes, nil
+}
+
+// iCalendarLocationParameter returns the TZID parameter and the layout for DateTimes in given location.
+// UTC and locations without loadable name, like fixed offsets, are written in UTC with "Z".
+func iCalendarLocationParameter(location *time.Location) (string, string) {
+	if location.String() == "" || location.String() == "UTC" {
+		return "", iCalendarDateTimeUTCLayout
+	}
+	if _, err := LoadLocation(location.String()); err != nil {
+		return "", iCalendarDateTimeUTCLayout
+	}
+	return ";TZID=" + location.String(), iCalendarDateTimeLayout
+}
@@ -0,0 +1,209 @@
+package gostradamus
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// averageDaysInYear and averageDaysInMonth of the gregorian calendar
+const (
+	averageDaysInYear  = 365.2425
+	averageDaysInMonth = averageDaysInYear / 12
+)
+
+// RelativeTimeFormats are the texts of relative times of a Locale.
+// Future and Past contain the relative time with %s, the plural texts the count with %d.
+type RelativeTimeFormats struct {
+	Future     string
+	Past       string
+	FewSeconds string
+	Seconds    string
+	Minute     string
+	Minutes    string
+	Hour       string
+	Hours      string
+	Day        string
+	Days       string
+	Month      string
+	Months     string
+	Year       string
+	Years      string
+}
+
+// CalendarFormats are the formats of DateTimes relative to a reference day, used by Calendar
+type CalendarFormats struct {
+	// SameDay is used for the day of the reference
+	SameDay string
+	// NextDay is used for the day after the reference
+	NextDay string
+	// NextWeek is used for the 2nd to 6th day after the reference
+	NextWeek string
+	// LastDay is used for the day before the reference
+	LastDay string
+	// LastWeek is used for the 2nd to 6th day before the reference
+	LastWeek string
+	// SameElse is used for all other days
+	SameElse string
+}
+
+// RelativeTimeThresholds decide from which duration on the next larger unit is used.
+// Every threshold is the count of the unit, below which the unit is used.
+type RelativeTimeThresholds struct {
+	// FewSeconds is the count of seconds below which "a few seconds" is used
+	FewSeconds int
+	// Seconds is the count of seconds below which the seconds are counted
+	Seconds int
+	// Minutes is the count of minutes below which the minutes are counted
+	Minutes int
+	// Hours is the count of hours below which the hours are counted
+	Hours int
+	// Days is the count of days below which the days are counted
+	Days int
+	// Months is the count of months below which the months are counted, years are used above
+	Months int
+}
+
+// DefaultRelativeTimeThresholds count minutes below 45, hours below 22, days below 26 and months below 11
+var DefaultRelativeTimeThresholds = RelativeTimeThresholds{
+	FewSeconds: 45,
+	Seconds:    45,
+	Minutes:    45,
+	Hours:      22,
+	Days:       26,
+	Months:     11,
+}
+
+// RelativeTime formats DateTimes relative to each other, like "3 hours ago" or "in 2 days"
+type RelativeTime struct {
+	// Locale provides the RelativeTimeFormats and CalendarFormats
+	Locale Locale
+	// Thresholds decide which unit is used
+	Thresholds RelativeTimeThresholds
+	// Rounding rounds the count of a unit, nil uses math.Round
+	Rounding func(float64) float64
+}
+
+// NewRelativeTime returns a new RelativeTime in given Locale with the DefaultRelativeTimeThresholds,
+// which rounds to the nearest count
+func NewRelativeTime(locale Locale) RelativeTime {
+	return RelativeTime{
+		Locale:     locale,
+		Thresholds: DefaultRelativeTimeThresholds,
+		Rounding:   math.Round,
+	}
+}
+
+// From returns the time from reference to dateTime
+//
+// For Example:
+//
+//	dateTime 3 hours before reference becomes "3 hours ago"
+func (rt RelativeTime) From(dateTime DateTime, reference DateTime) string {
+	return rt.Duration(dateTime.Time().Sub(reference.Time()))
+}
+
+// To returns the time from dateTime to reference
+//
+// For Example:
+//
+//	dateTime 3 hours before reference becomes "in 3 hours"
+func (rt RelativeTime) To(dateTime DateTime, reference DateTime) string {
+	return rt.From(reference, dateTime)
+}
+
+// Duration returns the relative time of given duration, which is in the future if positive and else in the past
+func (rt RelativeTime) Duration(duration time.Duration) string {
+	formats := rt.Locale.RelativeTimeFormats
+	relative := rt.duration(duration.Abs())
+	if duration < 0 {
+		return fmt.Sprintf(formats.Past, relative)
+	}
+	return fmt.Sprintf(formats.Future, relative)
+}
+
+// Calendar returns dateTime relative to the day of reference, like "Yesterday at 2:30 PM".
+// The days are compared in the timezone of reference.
+func (rt RelativeTime) Calendar(dateTime DateTime, reference DateTime) string {
+	dateTime = reference.inTimezoneOf(dateTime)
+	formats := rt.Locale.CalendarFormats
+	format := formats.SameElse
+	switch days := daysBetween(civilMidnight(reference), civilMidnight(dateTime)); {
+	case days == 0:
+		format = formats.SameDay
+	case days == 1:
+		format = formats.NextDay
+	case days > 1 && days < WeekInDays:
+		format = formats.NextWeek
+	case days == -1:
+		format = formats.LastDay
+	case days < -1 && days > -WeekInDays:
+		format = formats.LastWeek
+	}
+	return dateTime.FormatLocale(format, rt.Locale)
+}
+
+// duration returns the relative time of given positive duration without Future or Past
+func (rt RelativeTime) duration(duration time.Duration) string {
+	formats := rt.Locale.RelativeTimeFormats
+	thresholds := rt.Thresholds
+
+	seconds := duration.Seconds()
+	days := duration.Hours() / 24
+	switch {
+	case rt.round(seconds) < thresholds.FewSeconds:
+		return formats.FewSeconds
+	case rt.round(seconds) < thresholds.Seconds:
+		return fmt.Sprintf(formats.Seconds, rt.round(seconds))
+	case rt.round(duration.Minutes()) < thresholds.Minutes:
+		return rt.count(formats.Minute, formats.Minutes, rt.round(duration.Minutes()))
+	case rt.round(duration.Hours()) < thresholds.Hours:
+		return rt.count(formats.Hour, formats.Hours, rt.round(duration.Hours()))
+	case rt.round(days) < thresholds.Days:
+		return rt.count(formats.Day, formats.Days, rt.round(days))
+	case rt.round(days/averageDaysInMonth) < thresholds.Months:
+		return rt.count(formats.Month, formats.Months, rt.round(days/averageDaysInMonth))
+	}
+	return rt.count(formats.Year, formats.Years, rt.round(days/averageDaysInYear))
+}
+
+// count returns the singular text for a count up to one and else the plural text with the count
+func (rt RelativeTime) count(singular string, plural string, count int) string {
+	if count <= 1 {
+		return singular
+	}
+	return fmt.Sprintf(plural, count)
+}
+
+func (rt RelativeTime) round(value float64) int {
+	if rt.Rounding == nil {
+		return int(math.Round(value))
+	}
+	return int(rt.Rounding(value))
+}
+
+// From returns the current DateTime relative to other in english, like "3 hours ago"
+func (dt DateTime) From(other DateTime) string {
+	return NewRelativeTime(EnglishLocale).From(dt, other)
+}
+
+// FromNow returns the current DateTime relative to now in english, like "3 hours ago"
+func (dt DateTime) FromNow() string {
+	return dt.From(Now())
+}
+
+// To returns other relative to the current DateTime in english, like "in 3 hours"
+func (dt DateTime) To(other DateTime) string {
+	return NewRelativeTime(EnglishLocale).To(dt, other)
+}
+
+// ToNow returns now relative to the current DateTime in english, like "in 3 hours"
+func (dt DateTime) ToNow() string {
+	return dt.To(Now())
+}
+
+// Calendar returns the current DateTime relative to the day of reference in english, like "Yesterday at 2:30 PM".
+// Use Now() as reference for activity feeds.
+func (dt DateTime) Calendar(reference DateTime) string {
+	return NewRelativeTime(EnglishLocale).Calendar(dt, reference)
+}
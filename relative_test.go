@@ -0,0 +1,100 @@
+package gostradamus
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelativeTime_Duration(t *testing.T) {
+	relativeTime := NewRelativeTime(EnglishLocale)
+
+	tests := map[time.Duration]string{
+		0:                         "in a few seconds",
+		-44 * time.Second:         "a few seconds ago",
+		45 * time.Second:          "in a minute",
+		89 * time.Second:          "in a minute",
+		90 * time.Second:          "in 2 minutes",
+		-44 * time.Minute:         "44 minutes ago",
+		45 * time.Minute:          "in an hour",
+		-21 * time.Hour:           "21 hours ago",
+		22 * time.Hour:            "in a day",
+		-36 * time.Hour:           "2 days ago",
+		25 * 24 * time.Hour:       "in 25 days",
+		26 * 24 * time.Hour:       "in a month",
+		-45 * 24 * time.Hour:      "a month ago",
+		-46 * 24 * time.Hour:      "2 months ago",
+		319 * 24 * time.Hour:      "in 10 months",
+		320 * 24 * time.Hour:      "in a year",
+		-3 * 365 * 24 * time.Hour: "3 years ago",
+	}
+	for duration, expected := range tests {
+		assert.Equal(t, expected, relativeTime.Duration(duration), duration.String())
+	}
+}
+
+func TestRelativeTime_Thresholds(t *testing.T) {
+	relativeTime := NewRelativeTime(EnglishLocale)
+	relativeTime.Thresholds.FewSeconds = 10
+	relativeTime.Thresholds.Hours = 48
+	relativeTime.Rounding = math.Floor
+
+	assert.Equal(t, "in a few seconds", relativeTime.Duration(9*time.Second))
+	assert.Equal(t, "in 10 seconds", relativeTime.Duration(10*time.Second))
+	assert.Equal(t, "in a minute", relativeTime.Duration(119*time.Second))
+	assert.Equal(t, "in 36 hours", relativeTime.Duration(36*time.Hour+59*time.Minute))
+	assert.Equal(t, "in 2 days", relativeTime.Duration(48*time.Hour))
+}
+
+func TestRelativeTime_Locale(t *testing.T) {
+	reference := NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0)
+	dateTime := reference.ShiftHours(-3)
+
+	assert.Equal(t, "vor 3 Stunden", NewRelativeTime(GermanLocale).From(dateTime, reference))
+	assert.Equal(t, "in 3 Stunden", NewRelativeTime(GermanLocale).To(dateTime, reference))
+	assert.Equal(t, "il y a 3 heures", NewRelativeTime(FrenchLocale).From(dateTime, reference))
+	assert.Equal(t, "3時間前", NewRelativeTime(JapaneseLocale).From(dateTime, reference))
+	assert.Equal(t, "hace un día", NewRelativeTime(SpanishLocale).From(reference.ShiftDays(-1), reference))
+}
+
+func TestDateTime_From(t *testing.T) {
+	reference := NewUTCDateTime(2020, 1, 1, 12, 0, 0, 0)
+
+	assert.Equal(t, "3 hours ago", reference.ShiftHours(-3).From(reference))
+	assert.Equal(t, "in 2 days", reference.ShiftDays(2).From(reference))
+	assert.Equal(t, "in 3 hours", reference.ShiftHours(-3).To(reference))
+	assert.Equal(t, "2 days ago", reference.ShiftDays(2).To(reference))
+
+	assert.Equal(t, "3 hours ago", Now().ShiftHours(-3).FromNow())
+	assert.Equal(t, "in 3 hours", Now().ShiftHours(-3).ToNow())
+}
+
+func TestDateTime_Calendar(t *testing.T) {
+	// 2020-01-15 is a wednesday
+	reference := NewDateTime(2020, 1, 15, 12, 0, 0, 0, EuropeBerlin)
+
+	assert.Equal(t, "Today at 8:00 AM", NewDateTime(2020, 1, 15, 8, 0, 0, 0, EuropeBerlin).Calendar(reference))
+	assert.Equal(t, "Yesterday at 2:30 PM", NewDateTime(2020, 1, 14, 14, 30, 0, 0, EuropeBerlin).Calendar(reference))
+	assert.Equal(t, "Tomorrow at 11:59 PM", NewDateTime(2020, 1, 16, 23, 59, 0, 0, EuropeBerlin).Calendar(reference))
+	assert.Equal(t, "Next Monday at 9:00 AM", NewDateTime(2020, 1, 20, 9, 0, 0, 0, EuropeBerlin).Calendar(reference))
+	assert.Equal(t, "Last Thursday at 9:00 AM", NewDateTime(2020, 1, 9, 9, 0, 0, 0, EuropeBerlin).Calendar(reference))
+	assert.Equal(t, "01/22/2020", NewDateTime(2020, 1, 22, 9, 0, 0, 0, EuropeBerlin).Calendar(reference))
+	assert.Equal(t, "01/08/2020", NewDateTime(2020, 1, 8, 9, 0, 0, 0, EuropeBerlin).Calendar(reference))
+
+	// the day is decided in the timezone of the reference
+	assert.Equal(t, "Tomorrow at 12:30 AM", NewUTCDateTime(2020, 1, 15, 23, 30, 0, 0).Calendar(reference))
+
+	// fixed offsets without a Timezone name are kept
+	offsetReference := DateTimeFromTime(time.Date(2020, 1, 15, 1, 0, 0, 0, time.FixedZone("", 5*60*60)))
+	offsetNow := DateTimeFromTime(time.Date(2020, 1, 15, 0, 30, 0, 0, time.FixedZone("", 5*60*60)))
+	assert.Equal(t, "Today at 12:30 AM", NewRelativeTime(EnglishLocale).Calendar(offsetNow, offsetReference))
+	assert.Equal(t, "Yesterday at 11:30 PM", NewRelativeTime(EnglishLocale).Calendar(offsetNow.ShiftHours(-1), offsetReference))
+
+	assert.Equal(
+		t,
+		"gestern um 14:30 Uhr",
+		NewRelativeTime(GermanLocale).Calendar(NewDateTime(2020, 1, 14, 14, 30, 0, 0, EuropeBerlin), reference),
+	)
+}
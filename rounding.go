@@ -0,0 +1,143 @@
+package gostradamus
+
+import "time"
+
+// Round returns the DateTime rounded to the nearest start of given unit.
+// Halfway values round up, like time.Time.Round does.
+//
+// For Example:
+//
+//	2012-12-12 12:31:00.000000000 rounded to UnitHour becomes 2012-12-12 13:00:00.000000000
+//	2012-12-12 11:59:59.999999999 rounded to UnitDay becomes 2012-12-12 00:00:00.000000000
+//
+// Round panics if the unit is not supported
+func (dt DateTime) Round(unit Unit) DateTime {
+	floor := dt.Floor(unit)
+	next := dt.Ceil(unit).ShiftNanoseconds(1)
+	if dt.Time().Sub(floor.Time()) < next.Time().Sub(dt.Time()) {
+		return floor
+	}
+	return next
+}
+
+// FloorTo returns the DateTime floored to a multiple of given duration.
+//
+// The buckets are aligned to the wall clock of the DateTime's timezone,
+// therefore 15 minute buckets always start at :00, :15, :30 and :45 local time,
+// even in timezones with a non-hourly UTC offset.
+// Durations longer than a day are aligned to the proleptic 0001-01-01 (a monday),
+// so seven day buckets always start on a monday.
+// If duration <= 0, FloorTo returns the DateTime unchanged.
+//
+// For Example:
+//
+//	2012-12-12 12:12:12.123456789 floored to 5 minutes becomes 2012-12-12 12:10:00.000000000
+func (dt DateTime) FloorTo(duration time.Duration) DateTime {
+	if duration <= 0 {
+		return dt
+	}
+	return dt.fromWallClock(dt.wallClock().Truncate(duration))
+}
+
+// CeilTo returns the last nanosecond of the duration bucket the DateTime is in.
+// The buckets are aligned like in FloorTo.
+// If duration <= 0, CeilTo returns the DateTime unchanged.
+//
+// For Example:
+//
+//	2012-12-12 12:12:12.123456789 ceiled to 5 minutes becomes 2012-12-12 12:14:59.999999999
+func (dt DateTime) CeilTo(duration time.Duration) DateTime {
+	if duration <= 0 {
+		return dt
+	}
+	return dt.fromWallClock(dt.wallClock().Truncate(duration).Add(duration - 1))
+}
+
+// RoundTo returns the DateTime rounded to the nearest multiple of given duration.
+// The buckets are aligned like in FloorTo and halfway values round up.
+// If duration <= 0, RoundTo returns the DateTime unchanged.
+//
+// For Example:
+//
+//	2012-12-12 12:22:30.000000000 rounded to 15 minutes becomes 2012-12-12 12:30:00.000000000
+func (dt DateTime) RoundTo(duration time.Duration) DateTime {
+	if duration <= 0 {
+		return dt
+	}
+	return dt.fromWallClock(dt.wallClock().Round(duration))
+}
+
+// FloorToOrigin returns the DateTime floored to a multiple of given duration,
+// counted from origin. The buckets are measured in elapsed time and
+// the result is returned in the timezone of the current DateTime.
+// If duration <= 0, FloorToOrigin returns the DateTime unchanged.
+//
+// For Example:
+//
+//	2012-12-12 12:12:00 floored to 10 minutes from origin 2012-12-12 00:05:00 becomes 2012-12-12 12:05:00
+func (dt DateTime) FloorToOrigin(duration time.Duration, origin DateTime) DateTime {
+	if duration <= 0 {
+		return dt
+	}
+	return DateTimeFromTime(
+		origin.Time().Add(dt.bucketsSince(origin, duration) * duration).In(dt.Time().Location()),
+	)
+}
+
+// CeilToOrigin returns the last nanosecond of the duration bucket, counted from origin,
+// the DateTime is in. The buckets are aligned like in FloorToOrigin.
+// If duration <= 0, CeilToOrigin returns the DateTime unchanged.
+func (dt DateTime) CeilToOrigin(duration time.Duration, origin DateTime) DateTime {
+	if duration <= 0 {
+		return dt
+	}
+	return DateTimeFromTime(dt.FloorToOrigin(duration, origin).Time().Add(duration - 1))
+}
+
+// RoundToOrigin returns the DateTime rounded to the nearest multiple of given duration,
+// counted from origin. The buckets are aligned like in FloorToOrigin and halfway values round up.
+// If duration <= 0, RoundToOrigin returns the DateTime unchanged.
+func (dt DateTime) RoundToOrigin(duration time.Duration, origin DateTime) DateTime {
+	if duration <= 0 {
+		return dt
+	}
+	floor := dt.FloorToOrigin(duration, origin)
+	remainder := dt.Time().Sub(floor.Time())
+	if remainder < duration-remainder {
+		return floor
+	}
+	return DateTimeFromTime(floor.Time().Add(duration))
+}
+
+// bucketsSince returns how many whole durations lie between origin and the DateTime,
+// rounded towards negative infinity
+func (dt DateTime) bucketsSince(origin DateTime, duration time.Duration) time.Duration {
+	elapsed := dt.Time().Sub(origin.Time())
+	buckets := elapsed / duration
+	if elapsed%duration < 0 {
+		buckets--
+	}
+	return buckets
+}
+
+// wallClock returns the wall clock of the DateTime as time.Time in UTC
+func (dt DateTime) wallClock() time.Time {
+	t := dt.Time()
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// fromWallClock returns a DateTime in the timezone of current DateTime with the given wall clock
+func (dt DateTime) fromWallClock(wallClock time.Time) DateTime {
+	return DateTimeFromTime(
+		time.Date(
+			wallClock.Year(),
+			wallClock.Month(),
+			wallClock.Day(),
+			wallClock.Hour(),
+			wallClock.Minute(),
+			wallClock.Second(),
+			wallClock.Nanosecond(),
+			dt.Time().Location(),
+		),
+	)
+}
@@ -0,0 +1,128 @@
+package gostradamus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateTime_Round(t *testing.T) {
+	actual := NewUTCDateTime(2012, 12, 12, 12, 31, 0, 0).Round(UnitHour)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 13, 0, 0, 0), actual)
+
+	actual = NewUTCDateTime(2012, 12, 12, 12, 29, 59, 999999999).Round(UnitHour)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 0, 0, 0), actual)
+
+	// Halfway values round up
+	actual = NewUTCDateTime(2012, 12, 12, 12, 0, 0, 0).Round(UnitDay)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 13, 0, 0, 0, 0), actual)
+
+	actual = NewUTCDateTime(2012, 12, 12, 12, 12, 12, 123456789).Round(UnitMillisecond)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 12, 12, 123000000), actual)
+
+	actual = NewUTCDateTime(2012, 12, 12, 12, 12, 12, 123456789).Round(UnitMicrosecond)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 12, 12, 123457000), actual)
+
+	actual = NewUTCDateTime(2012, 12, 12, 12, 12, 12, 123456789).Round(UnitNanosecond)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 12, 12, 123456789), actual)
+
+	actual = NewUTCDateTime(2012, 2, 16, 0, 0, 0, 0).Round(UnitMonth)
+	assert.Equal(t, NewUTCDateTime(2012, 3, 1, 0, 0, 0, 0), actual)
+
+	actual = NewUTCDateTime(2012, 6, 30, 0, 0, 0, 0).Round(UnitYear)
+	assert.Equal(t, NewUTCDateTime(2012, 1, 1, 0, 0, 0, 0), actual)
+
+	// Friday rounds to the following monday
+	actual = NewUTCDateTime(2012, 12, 14, 0, 0, 0, 0).Round(UnitWeek)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 17, 0, 0, 0, 0), actual)
+
+	assert.PanicsWithError(
+		t,
+		"Unit: 42 is not supported",
+		func() {
+			NewUTCDateTime(2012, 12, 12, 12, 12, 12, 0).Round(Unit(42))
+		},
+	)
+}
+
+func TestDateTime_FloorTo(t *testing.T) {
+	actual := NewUTCDateTime(2012, 12, 12, 12, 12, 12, 123456789).FloorTo(5 * time.Minute)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 10, 0, 0), actual)
+
+	// Buckets are aligned to local midnight, not to UTC
+	actual = NewDateTime(2012, 12, 12, 12, 20, 0, 0, AsiaKathmandu).FloorTo(time.Hour)
+	assert.Equal(t, NewDateTime(2012, 12, 12, 12, 0, 0, 0, AsiaKathmandu), actual)
+
+	// Seven day buckets start on monday
+	actual = NewUTCDateTime(2012, 12, 12, 12, 12, 12, 0).FloorTo(7 * 24 * time.Hour)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 10, 0, 0, 0, 0), actual)
+
+	actual = NewUTCDateTime(2012, 12, 12, 12, 12, 12, 0).FloorTo(0)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 12, 12, 0), actual)
+}
+
+func TestDateTime_CeilTo(t *testing.T) {
+	actual := NewUTCDateTime(2012, 12, 12, 12, 12, 12, 123456789).CeilTo(5 * time.Minute)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 14, 59, 999999999), actual)
+
+	actual = NewDateTime(2012, 12, 12, 12, 20, 0, 0, AsiaKathmandu).CeilTo(time.Hour)
+	assert.Equal(t, NewDateTime(2012, 12, 12, 12, 59, 59, 999999999, AsiaKathmandu), actual)
+
+	actual = NewUTCDateTime(2012, 12, 12, 12, 12, 12, 0).CeilTo(-time.Minute)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 12, 12, 0), actual)
+}
+
+func TestDateTime_RoundTo(t *testing.T) {
+	actual := NewUTCDateTime(2012, 12, 12, 12, 22, 30, 0).RoundTo(15 * time.Minute)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 30, 0, 0), actual)
+
+	actual = NewUTCDateTime(2012, 12, 12, 12, 22, 29, 999999999).RoundTo(15 * time.Minute)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 15, 0, 0), actual)
+
+	actual = NewDateTime(2012, 12, 12, 12, 40, 0, 0, AsiaKathmandu).RoundTo(time.Hour)
+	assert.Equal(t, NewDateTime(2012, 12, 12, 13, 0, 0, 0, AsiaKathmandu), actual)
+}
+
+func TestDateTime_FloorToOrigin(t *testing.T) {
+	origin := NewUTCDateTime(2012, 12, 12, 0, 5, 0, 0)
+
+	actual := NewUTCDateTime(2012, 12, 12, 12, 12, 0, 0).FloorToOrigin(10*time.Minute, origin)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 5, 0, 0), actual)
+
+	// DateTimes before the origin are floored as well
+	actual = NewUTCDateTime(2012, 12, 11, 23, 59, 0, 0).FloorToOrigin(10*time.Minute, origin)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 11, 23, 55, 0, 0), actual)
+
+	// The result keeps the timezone of the DateTime
+	actual = NewDateTime(2012, 12, 12, 13, 12, 0, 0, EuropeBerlin).FloorToOrigin(10*time.Minute, origin)
+	assert.Equal(t, NewDateTime(2012, 12, 12, 13, 5, 0, 0, EuropeBerlin), actual)
+
+	actual = NewUTCDateTime(2012, 12, 12, 12, 12, 0, 0).FloorToOrigin(0, origin)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 12, 0, 0), actual)
+}
+
+func TestDateTime_CeilToOrigin(t *testing.T) {
+	origin := NewUTCDateTime(2012, 12, 12, 0, 5, 0, 0)
+
+	actual := NewUTCDateTime(2012, 12, 12, 12, 12, 0, 0).CeilToOrigin(10*time.Minute, origin)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 14, 59, 999999999), actual)
+}
+
+func TestDateTime_RoundToOrigin(t *testing.T) {
+	origin := NewUTCDateTime(2012, 12, 12, 0, 5, 0, 0)
+
+	actual := NewUTCDateTime(2012, 12, 12, 12, 10, 0, 0).RoundToOrigin(10*time.Minute, origin)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 15, 0, 0), actual)
+
+	actual = NewUTCDateTime(2012, 12, 12, 12, 9, 59, 0).RoundToOrigin(10*time.Minute, origin)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 5, 0, 0), actual)
+}
+
+func TestDateTime_Round_Quarter(t *testing.T) {
+	actual := NewUTCDateTime(2012, 2, 20, 0, 0, 0, 0).Round(UnitQuarter)
+	assert.Equal(t, NewUTCDateTime(2012, 4, 1, 0, 0, 0, 0), actual)
+
+	actual = NewUTCDateTime(2014, 8, 15, 0, 0, 0, 0).Round(UnitDecade)
+	assert.Equal(t, NewUTCDateTime(2010, 1, 1, 0, 0, 0, 0), actual)
+}
@@ -0,0 +1,576 @@
+package gostradamus
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the FREQ of an RRule, which defines the period occurrences are generated in
+type Frequency int
+
+// All Frequencies of RFC 5545
+const (
+	FrequencySecondly Frequency = iota
+	FrequencyMinutely
+	FrequencyHourly
+	FrequencyDaily
+	FrequencyWeekly
+	FrequencyMonthly
+	FrequencyYearly
+)
+
+// maxEmptyRRulePeriods is the count of consecutive periods without occurrence,
+// after which an RRule is considered to never match again, like BYMONTH=2;BYMONTHDAY=30
+const maxEmptyRRulePeriods = 10000
+
+const (
+	iCalendarDateTimeUTCLayout = "20060102T150405Z"
+	iCalendarDateTimeLayout    = "20060102T150405"
+	iCalendarDateLayout        = "20060102"
+)
+
+var (
+	frequencyNames = map[Frequency]string{
+		FrequencySecondly: "SECONDLY",
+		FrequencyMinutely: "MINUTELY",
+		FrequencyHourly:   "HOURLY",
+		FrequencyDaily:    "DAILY",
+		FrequencyWeekly:   "WEEKLY",
+		FrequencyMonthly:  "MONTHLY",
+		FrequencyYearly:   "YEARLY",
+	}
+
+	weekdayCodes = map[time.Weekday]string{
+		time.Sunday:    "SU",
+		time.Monday:    "MO",
+		time.Tuesday:   "TU",
+		time.Wednesday: "WE",
+		time.Thursday:  "TH",
+		time.Friday:    "FR",
+		time.Saturday:  "SA",
+	}
+)
+
+// String returns the Frequency as used in FREQ, for example "WEEKLY"
+func (f Frequency) String() string {
+	if name, ok := frequencyNames[f]; ok {
+		return name
+	}
+	return fmt.Sprintf("Frequency(%d)", int(f))
+}
+
+// NthWeekday is a BYDAY value like MO, 2TU or -1FR.
+// N is 0 for every such weekday of the period,
+// positive to count from the start of the period and negative to count from its end.
+type NthWeekday struct {
+	Weekday time.Weekday
+	N       int
+}
+
+// String returns the NthWeekday as used in BYDAY, for example "-1FR"
+func (w NthWeekday) String() string {
+	if w.N == 0 {
+		return weekdayCodes[w.Weekday]
+	}
+	return strconv.Itoa(w.N) + weekdayCodes[w.Weekday]
+}
+
+// RRule is a recurrence rule of RFC 5545.
+// Use NewRRule or ParseRRule to create an RRule, because the zero value starts weeks on sunday.
+//
+// BYYEARDAY and BYWEEKNO are not supported.
+type RRule struct {
+	Frequency Frequency
+	// Interval is the count of periods between two periods with occurrences, values below 1 are treated as 1
+	Interval int
+	// Count is the maximal count of occurrences, 0 means unlimited
+	Count int
+	// Until is the last DateTime an occurrence can be at, the zero DateTime means unlimited
+	Until      DateTime
+	ByMonth    []int
+	ByMonthDay []int
+	ByDay      []NthWeekday
+	ByHour     []int
+	ByMinute   []int
+	BySecond   []int
+	BySetPos   []int
+	WeekStart  time.Weekday
+}
+
+// NewRRule returns a new RRule with given Frequency, an Interval of 1 and weeks starting on monday
+func NewRRule(frequency Frequency) RRule {
+	return RRule{Frequency: frequency, Interval: 1, WeekStart: time.Monday}
+}
+
+// ParseRRule parses a recurrence rule like "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3" into a new RRule.
+// The prefix "RRULE:" is optional. An UNTIL without "Z" is parsed in UTC.
+func ParseRRule(value string) (RRule, error) {
+	return ParseRRuleInTimezone(value, UTC)
+}
+
+// ParseRRuleInTimezone parses a recurrence rule like ParseRRule, but parses an UNTIL without "Z" in given timezone
+func ParseRRuleInTimezone(value string, timezone Timezone) (RRule, error) {
+	rule := NewRRule(FrequencyYearly)
+	hasFrequency := false
+
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(value), "RRULE:"), ";") {
+		if part == "" {
+			continue
+		}
+		name, partValue, found := strings.Cut(part, "=")
+		if !found {
+			return RRule{}, RRuleIsNotParsable(value)
+		}
+
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			rule.Frequency, err = parseFrequency(partValue)
+			hasFrequency = true
+		case "INTERVAL":
+			rule.Interval, err = parseRRuleNumber(partValue, 1, math.MaxInt32)
+		case "COUNT":
+			rule.Count, err = parseRRuleNumber(partValue, 1, math.MaxInt32)
+		case "UNTIL":
+			rule.Until, err = parseICalendarDateTime(partValue, timezone.Location())
+		case "BYMONTH":
+			rule.ByMonth, err = parseRRuleNumbers(partValue, 1, 12, false)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseRRuleNumbers(partValue, 1, 31, true)
+		case "BYDAY":
+			rule.ByDay, err = parseNthWeekdays(partValue)
+		case "BYHOUR":
+			rule.ByHour, err = parseRRuleNumbers(partValue, 0, 23, false)
+		case "BYMINUTE":
+			rule.ByMinute, err = parseRRuleNumbers(partValue, 0, 59, false)
+		case "BYSECOND":
+			rule.BySecond, err = parseRRuleNumbers(partValue, 0, 59, false)
+		case "BYSETPOS":
+			rule.BySetPos, err = parseRRuleNumbers(partValue, 1, 366, true)
+		case "WKST":
+			rule.WeekStart, err = parseWeekdayCode(partValue)
+		case "BYYEARDAY", "BYWEEKNO":
+			return RRule{}, RRulePartIsNotSupported(strings.ToUpper(name))
+		default:
+			return RRule{}, RRuleIsNotParsable(value)
+		}
+		if err != nil {
+			return RRule{}, RRuleIsNotParsable(value)
+		}
+	}
+
+	if !hasFrequency || (rule.Count > 0 && !rule.Until.Time().IsZero()) {
+		return RRule{}, RRuleIsNotParsable(value)
+	}
+	// BYDAY can only count weekdays in months and years
+	if rule.Frequency != FrequencyMonthly && rule.Frequency != FrequencyYearly {
+		for _, weekday := range rule.ByDay {
+			if weekday.N != 0 {
+				return RRule{}, RRuleIsNotParsable(value)
+			}
+		}
+	}
+	return rule, nil
+}
+
+// String returns the RRule as recurrence rule without "RRULE:" prefix
+// Example: "FREQ=MONTHLY;COUNT=3;BYDAY=-1FR"
+func (r RRule) String() string {
+	parts := []string{"FREQ=" + r.Frequency.String()}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.Time().IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.Time().UTC().Format(iCalendarDateTimeUTCLayout))
+	}
+
+	numbers := func(name string, values []int) {
+		if len(values) == 0 {
+			return
+		}
+		formatted := make([]string, len(values))
+		for i, value := range values {
+			formatted[i] = strconv.Itoa(value)
+		}
+		parts = append(parts, name+"="+strings.Join(formatted, ","))
+	}
+	numbers("BYMONTH", r.ByMonth)
+	numbers("BYMONTHDAY", r.ByMonthDay)
+	if len(r.ByDay) > 0 {
+		weekdays := make([]string, len(r.ByDay))
+		for i, weekday := range r.ByDay {
+			weekdays[i] = weekday.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(weekdays, ","))
+	}
+	numbers("BYHOUR", r.ByHour)
+	numbers("BYMINUTE", r.ByMinute)
+	numbers("BYSECOND", r.BySecond)
+	numbers("BYSETPOS", r.BySetPos)
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayCodes[r.WeekStart])
+	}
+	return strings.Join(parts, ";")
+}
+
+// MarshalText implements the encoding.TextMarshaler interface,
+// which is used for JSON as well
+func (r RRule) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// which is used for JSON as well
+func (r *RRule) UnmarshalText(text []byte) error {
+	rule, err := ParseRRule(string(text))
+	if err != nil {
+		return err
+	}
+	*r = rule
+	return nil
+}
+
+// occurrences calls yield with every occurrence of the RRule from start on, until yield returns false
+func (r RRule) occurrences(start DateTime, yield func(DateTime) bool) {
+	r = r.withDefaults(start.Time())
+	until := r.Until.Time()
+	count := 0
+	emptyPeriods := 0
+
+	for period := 0; emptyPeriods < maxEmptyRRulePeriods; {
+		candidates, next := r.expand(start.Time(), period)
+		period = next
+
+		candidates = applySetPositions(candidates, r.BySetPos)
+		if len(candidates) == 0 {
+			emptyPeriods++
+			continue
+		}
+		emptyPeriods = 0
+
+		for _, candidate := range candidates {
+			if candidate.Before(start.Time()) {
+				continue
+			}
+			if !until.IsZero() && candidate.After(until) {
+				return
+			}
+			if !yield(DateTimeFromTime(candidate)) {
+				return
+			}
+			count++
+			if r.Count > 0 && count >= r.Count {
+				return
+			}
+		}
+	}
+}
+
+// withDefaults returns the RRule with the BY parts, which are implied by start
+func (r RRule) withDefaults(start time.Time) RRule {
+	r.Interval = max(r.Interval, 1)
+	switch r.Frequency {
+	case FrequencyYearly:
+		if len(r.ByMonth) == 0 && len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+			r.ByMonth = []int{int(start.Month())}
+		}
+		if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+			r.ByMonthDay = []int{start.Day()}
+		}
+	case FrequencyMonthly:
+		if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+			r.ByMonthDay = []int{start.Day()}
+		}
+	case FrequencyWeekly:
+		if len(r.ByDay) == 0 {
+			r.ByDay = []NthWeekday{{Weekday: start.Weekday()}}
+		}
+	}
+	if r.Frequency >= FrequencyDaily {
+		if len(r.ByHour) == 0 {
+			r.ByHour = []int{start.Hour()}
+		}
+		if len(r.ByMinute) == 0 {
+			r.ByMinute = []int{start.Minute()}
+		}
+		if len(r.BySecond) == 0 {
+			r.BySecond = []int{start.Second()}
+		}
+	}
+	return r
+}
+
+// expand returns the sorted candidates of given period and the index of the next period worth expanding
+func (r RRule) expand(start time.Time, period int) ([]time.Time, int) {
+	if r.Frequency < FrequencyDaily {
+		return r.expandClock(start, period)
+	}
+
+	var candidates []time.Time
+	for _, day := range r.periodDays(start, period) {
+		for _, hour := range r.ByHour {
+			for _, minute := range r.ByMinute {
+				for _, second := range r.BySecond {
+					candidates = append(
+						candidates,
+						time.Date(
+							day.Year(),
+							day.Month(),
+							day.Day(),
+							hour,
+							minute,
+							second,
+							start.Nanosecond(),
+							start.Location(),
+						),
+					)
+				}
+			}
+		}
+	}
+	return sortedTimes(candidates), period + 1
+}
+
+// periodDays returns the days of given period, which match BYMONTH, BYMONTHDAY and BYDAY, as UTC midnights
+func (r RRule) periodDays(start time.Time, period int) []time.Time {
+	date := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	offset := period * r.Interval
+
+	var first, last time.Time
+	switch r.Frequency {
+	case FrequencyYearly:
+		first = time.Date(date.Year()+offset, 1, 1, 0, 0, 0, 0, time.UTC)
+		last = first.AddDate(1, 0, 0)
+	case FrequencyMonthly:
+		first = time.Date(date.Year(), date.Month()+time.Month(offset), 1, 0, 0, 0, 0, time.UTC)
+		last = first.AddDate(0, 1, 0)
+	case FrequencyWeekly:
+		first = date.AddDate(0, 0, offset*WeekInDays-daysSinceWeekday(date.Weekday(), r.WeekStart))
+		last = first.AddDate(0, 0, WeekInDays)
+	default:
+		first = date.AddDate(0, 0, offset)
+		last = first.AddDate(0, 0, 1)
+	}
+
+	var days []time.Time
+	for day := first; day.Before(last); day = day.AddDate(0, 0, 1) {
+		if r.matchesDay(day) {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+// expandClock returns the candidates of given period for the frequencies below a day.
+// Their periods are measured in elapsed time, so daylight saving time transitions neither skip nor repeat occurrences.
+// Periods, which can not match, are skipped up to the next day, hour or minute.
+func (r RRule) expandClock(start time.Time, period int) ([]time.Time, int) {
+	step := time.Duration(r.Interval) * time.Second
+	switch r.Frequency {
+	case FrequencyMinutely:
+		step = time.Duration(r.Interval) * time.Minute
+	case FrequencyHourly:
+		step = time.Duration(r.Interval) * time.Hour
+	}
+	current := start.Add(time.Duration(period) * step)
+
+	skipTo := func(next time.Time) ([]time.Time, int) {
+		periods := int((next.Sub(start) + step - 1) / step)
+		return nil, max(periods, period+1)
+	}
+	untilNextMinute := time.Duration(current.Second())*time.Second + time.Duration(current.Nanosecond())
+	untilNextHour := time.Duration(current.Minute())*time.Minute + untilNextMinute
+
+	if !r.matchesDay(time.Date(current.Year(), current.Month(), current.Day(), 0, 0, 0, 0, time.UTC)) {
+		return skipTo(time.Date(current.Year(), current.Month(), current.Day()+1, 0, 0, 0, 0, current.Location()))
+	}
+	if len(r.ByHour) > 0 && !slices.Contains(r.ByHour, current.Hour()) {
+		return skipTo(current.Add(time.Hour - untilNextHour))
+	}
+	if r.Frequency != FrequencyHourly && len(r.ByMinute) > 0 && !slices.Contains(r.ByMinute, current.Minute()) {
+		return skipTo(current.Add(time.Minute - untilNextMinute))
+	}
+	if r.Frequency == FrequencySecondly && len(r.BySecond) > 0 && !slices.Contains(r.BySecond, current.Second()) {
+		return nil, period + 1
+	}
+
+	minutes := []int{current.Minute()}
+	if r.Frequency == FrequencyHourly && len(r.ByMinute) > 0 {
+		minutes = r.ByMinute
+	}
+	seconds := []int{current.Second()}
+	if r.Frequency != FrequencySecondly && len(r.BySecond) > 0 {
+		seconds = r.BySecond
+	}
+
+	var candidates []time.Time
+	for _, minute := range minutes {
+		for _, second := range seconds {
+			candidates = append(
+				candidates,
+				current.Add(
+					time.Duration(minute-current.Minute())*time.Minute+
+						time.Duration(second-current.Second())*time.Second,
+				),
+			)
+		}
+	}
+	return sortedTimes(candidates), period + 1
+}
+
+// matchesDay checks if the day, given as UTC midnight, matches BYMONTH, BYMONTHDAY and BYDAY
+func (r RRule) matchesDay(day time.Time) bool {
+	if len(r.ByMonth) > 0 && !slices.Contains(r.ByMonth, int(day.Month())) {
+		return false
+	}
+	if len(r.ByMonthDay) > 0 {
+		lastDay := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		matches := slices.ContainsFunc(r.ByMonthDay, func(monthDay int) bool {
+			return monthDay == day.Day() || monthDay < 0 && lastDay+monthDay+1 == day.Day()
+		})
+		if !matches {
+			return false
+		}
+	}
+	if len(r.ByDay) > 0 {
+		return slices.ContainsFunc(r.ByDay, func(weekday NthWeekday) bool {
+			return r.matchesNthWeekday(weekday, day)
+		})
+	}
+	return true
+}
+
+// matchesNthWeekday checks if the day, given as UTC midnight, is the NthWeekday.
+// N counts in the year for yearly rules without BYMONTH and in the month otherwise.
+func (r RRule) matchesNthWeekday(weekday NthWeekday, day time.Time) bool {
+	if day.Weekday() != weekday.Weekday {
+		return false
+	}
+	if weekday.N == 0 || (r.Frequency != FrequencyMonthly && r.Frequency != FrequencyYearly) {
+		return true
+	}
+
+	first := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1)
+	if r.Frequency == FrequencyYearly && len(r.ByMonth) == 0 {
+		first = time.Date(day.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+		last = time.Date(day.Year(), 12, 31, 0, 0, 0, 0, time.UTC)
+	}
+	if weekday.N > 0 {
+		return (day.YearDay()-first.YearDay())/WeekInDays+1 == weekday.N
+	}
+	return (last.YearDay()-day.YearDay())/WeekInDays+1 == -weekday.N
+}
+
+// applySetPositions returns the candidates at given BYSETPOS positions, negative positions count from the end
+func applySetPositions(candidates []time.Time, positions []int) []time.Time {
+	if len(positions) == 0 {
+		return candidates
+	}
+	var selected []time.Time
+	for _, position := range positions {
+		index := position - 1
+		if position < 0 {
+			index = len(candidates) + position
+		}
+		if index >= 0 && index < len(candidates) {
+			selected = append(selected, candidates[index])
+		}
+	}
+	return sortedTimes(selected)
+}
+
+// sortedTimes sorts the times and removes duplicated instants,
+// which appear when wall clocks in a daylight saving time gap are moved
+func sortedTimes(times []time.Time) []time.Time {
+	slices.SortFunc(times, time.Time.Compare)
+	return slices.CompactFunc(times, time.Time.Equal)
+}
+
+func parseFrequency(value string) (Frequency, error) {
+	for frequency, name := range frequencyNames {
+		if strings.EqualFold(name, value) {
+			return frequency, nil
+		}
+	}
+	return 0, RRuleIsNotParsable(value)
+}
+
+func parseWeekdayCode(value string) (time.Weekday, error) {
+	for weekday, code := range weekdayCodes {
+		if strings.EqualFold(code, value) {
+			return weekday, nil
+		}
+	}
+	return 0, RRuleIsNotParsable(value)
+}
+
+func parseNthWeekdays(value string) ([]NthWeekday, error) {
+	var weekdays []NthWeekday
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, RRuleIsNotParsable(value)
+		}
+		weekday, err := parseWeekdayCode(item[len(item)-2:])
+		if err != nil {
+			return nil, err
+		}
+		n := 0
+		if number := item[:len(item)-2]; number != "" {
+			n, err = parseRRuleNumber(strings.TrimPrefix(number, "+"), -53, 53)
+			if err != nil || n == 0 {
+				return nil, RRuleIsNotParsable(value)
+			}
+		}
+		weekdays = append(weekdays, NthWeekday{Weekday: weekday, N: n})
+	}
+	return weekdays, nil
+}
+
+// parseRRuleNumbers parses a comma separated list of numbers between minimum and maximum.
+// If signed is true, the negated numbers are accepted as well.
+func parseRRuleNumbers(value string, minimum int, maximum int, signed bool) ([]int, error) {
+	var numbers []int
+	for _, item := range strings.Split(value, ",") {
+		number, err := parseRRuleNumber(strings.TrimPrefix(item, "+"), -maximum, maximum)
+		if err != nil {
+			return nil, err
+		}
+		if number < minimum && !(signed && -number >= minimum) {
+			return nil, RRuleIsNotParsable(value)
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, nil
+}
+
+func parseRRuleNumber(value string, minimum int, maximum int) (int, error) {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < minimum || number > maximum {
+		return 0, RRuleIsNotParsable(value)
+	}
+	return number, nil
+}
+
+// parseICalendarDateTime parses an iCalendar DATE-TIME or DATE value,
+// values without "Z" are parsed in given location
+func parseICalendarDateTime(value string, location *time.Location) (DateTime, error) {
+	var parsedTime time.Time
+	var err error
+	switch {
+	case strings.HasSuffix(value, "Z"):
+		parsedTime, err = time.Parse(iCalendarDateTimeUTCLayout, value)
+	case len(value) == len(iCalendarDateLayout):
+		parsedTime, err = time.ParseInLocation(iCalendarDateLayout, value, location)
+	default:
+		parsedTime, err = time.ParseInLocation(iCalendarDateTimeLayout, value, location)
+	}
+	return DateTimeFromTime(parsedTime), err
+}
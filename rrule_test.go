@@ -0,0 +1,338 @@
+package gostradamus
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// occurrencesOf returns the first limit occurrences of the rule starting at start
+func occurrencesOf(t *testing.T, start DateTime, rule string, limit int) []DateTime {
+	rRule, err := ParseRRule(rule)
+	assert.NoError(t, err)
+	var occurrences []DateTime
+	for occurrence := range NewRecurrence(start, rRule).All() {
+		occurrences = append(occurrences, occurrence)
+		if len(occurrences) == limit {
+			break
+		}
+	}
+	return occurrences
+}
+
+// utcDays returns DateTimes in UTC at 09:00 for every given year, month and day triple
+func utcDays(values ...int) []DateTime {
+	var dateTimes []DateTime
+	for i := 0; i+2 < len(values); i += 3 {
+		dateTimes = append(dateTimes, NewUTCDateTime(values[i], values[i+1], values[i+2], 9, 0, 0, 0))
+	}
+	return dateTimes
+}
+
+func TestParseRRule(t *testing.T) {
+	actual, err := ParseRRule("RRULE:FREQ=MONTHLY;INTERVAL=2;COUNT=10;BYDAY=1FR,-1MO,TU;BYMONTHDAY=-1,13;WKST=SU")
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		RRule{
+			Frequency:  FrequencyMonthly,
+			Interval:   2,
+			Count:      10,
+			ByMonthDay: []int{-1, 13},
+			ByDay: []NthWeekday{
+				{Weekday: time.Friday, N: 1},
+				{Weekday: time.Monday, N: -1},
+				{Weekday: time.Tuesday},
+			},
+			WeekStart: time.Sunday,
+		},
+		actual,
+	)
+
+	actual, err = ParseRRuleInTimezone("FREQ=DAILY;UNTIL=20121224T090000", EuropeBerlin)
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2012, 12, 24, 9, 0, 0, 0, EuropeBerlin), actual.Until)
+
+	actual, err = ParseRRule("freq=weekly;until=20121224T090000Z;byhour=9,18")
+	assert.NoError(t, err)
+	assert.Equal(t, FrequencyWeekly, actual.Frequency)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 24, 9, 0, 0, 0), actual.Until)
+	assert.Equal(t, []int{9, 18}, actual.ByHour)
+}
+
+func TestParseRRule_Errors(t *testing.T) {
+	for _, value := range []string{
+		"",
+		"COUNT=3",
+		"FREQ=FORTNIGHTLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=3;UNTIL=20121224T090000Z",
+		"FREQ=DAILY;UNTIL=tomorrow",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=YEARLY;BYMONTH=13",
+		"FREQ=MONTHLY;BYDAY=0MO",
+		"FREQ=MONTHLY;BYDAY=XX",
+		"FREQ=WEEKLY;BYDAY=2MO",
+		"FREQ=DAILY;BYHOUR=24",
+		"FREQ=DAILY;WKST=MONDAY",
+		"FREQ=DAILY;COLOR=RED",
+		"FREQ=DAILY;COUNT",
+	} {
+		_, err := ParseRRule(value)
+		assert.Equal(t, RRuleIsNotParsable(value), err, value)
+	}
+
+	_, err := ParseRRule("FREQ=YEARLY;BYWEEKNO=20")
+	assert.Equal(t, RRulePartIsNotSupported("BYWEEKNO"), err)
+}
+
+func TestRRule_String(t *testing.T) {
+	for _, value := range []string{
+		"FREQ=DAILY",
+		"FREQ=WEEKLY;INTERVAL=2;UNTIL=19971224T000000Z;BYDAY=MO,WE,FR;WKST=SU",
+		"FREQ=MONTHLY;COUNT=10;BYMONTHDAY=-1,13;BYDAY=1FR,-2MO",
+		"FREQ=YEARLY;BYMONTH=1,2;BYHOUR=8;BYMINUTE=30;BYSECOND=15;BYSETPOS=-1",
+	} {
+		rule, err := ParseRRule(value)
+		assert.NoError(t, err)
+		assert.Equal(t, value, rule.String())
+	}
+
+	rule := NewRRule(FrequencyDaily)
+	rule.Until = NewDateTime(2012, 12, 24, 9, 0, 0, 0, EuropeBerlin)
+	assert.Equal(t, "FREQ=DAILY;UNTIL=20121224T080000Z", rule.String())
+	assert.Equal(t, "Frequency(42)", Frequency(42).String())
+}
+
+func TestRRule_JSON(t *testing.T) {
+	rule := NewRRule(FrequencyWeekly)
+	rule.ByDay = []NthWeekday{{Weekday: time.Monday}}
+
+	actual, err := json.Marshal(map[string]RRule{"rule": rule})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"rule":"FREQ=WEEKLY;BYDAY=MO"}`, string(actual))
+
+	var decoded map[string]RRule
+	assert.NoError(t, json.Unmarshal(actual, &decoded))
+	assert.Equal(t, rule, decoded["rule"])
+
+	assert.Error(t, json.Unmarshal([]byte(`{"rule":"FREQ=SOMETIMES"}`), &decoded))
+}
+
+func TestRRule_Daily(t *testing.T) {
+	actual := occurrencesOf(t, NewUTCDateTime(1997, 9, 2, 9, 0, 0, 0), "FREQ=DAILY;COUNT=10", 100)
+	assert.Len(t, actual, 10)
+	assert.Equal(t, NewUTCDateTime(1997, 9, 2, 9, 0, 0, 0), actual[0])
+	assert.Equal(t, NewUTCDateTime(1997, 9, 11, 9, 0, 0, 0), actual[9])
+
+	// Daily occurrences keep the wall clock across daylight saving time transitions
+	actual = occurrencesOf(t, NewDateTime(2012, 3, 24, 9, 0, 0, 0, EuropeBerlin), "FREQ=DAILY;COUNT=3", 100)
+	assert.Equal(
+		t,
+		[]DateTime{
+			NewDateTime(2012, 3, 24, 9, 0, 0, 0, EuropeBerlin),
+			NewDateTime(2012, 3, 25, 9, 0, 0, 0, EuropeBerlin),
+			NewDateTime(2012, 3, 26, 9, 0, 0, 0, EuropeBerlin),
+		},
+		actual,
+	)
+}
+
+func TestRRule_Weekly(t *testing.T) {
+	actual := occurrencesOf(
+		t,
+		NewUTCDateTime(1997, 9, 2, 9, 0, 0, 0),
+		"FREQ=WEEKLY;UNTIL=19971007T000000Z;WKST=SU;BYDAY=TU,TH",
+		100,
+	)
+	assert.Equal(t, utcDays(1997, 9, 2, 1997, 9, 4, 1997, 9, 9, 1997, 9, 11, 1997, 9, 16, 1997, 9, 18, 1997, 9, 23, 1997, 9, 25, 1997, 9, 30, 1997, 10, 2), actual)
+
+	actual = occurrencesOf(
+		t,
+		NewUTCDateTime(1997, 9, 1, 9, 0, 0, 0),
+		"FREQ=WEEKLY;INTERVAL=2;UNTIL=19971224T000000Z;WKST=SU;BYDAY=MO,WE,FR",
+		100,
+	)
+	assert.Len(t, actual, 25)
+	assert.Equal(t, utcDays(1997, 9, 1, 1997, 9, 3, 1997, 9, 5, 1997, 9, 15), actual[:4])
+	assert.Equal(t, NewUTCDateTime(1997, 12, 22, 9, 0, 0, 0), actual[24])
+
+	// WKST changes which weeks are skipped
+	actual = occurrencesOf(t, NewUTCDateTime(1997, 8, 5, 9, 0, 0, 0), "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=MO", 100)
+	assert.Equal(t, utcDays(1997, 8, 5, 1997, 8, 10, 1997, 8, 19, 1997, 8, 24), actual)
+	actual = occurrencesOf(t, NewUTCDateTime(1997, 8, 5, 9, 0, 0, 0), "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=SU", 100)
+	assert.Equal(t, utcDays(1997, 8, 5, 1997, 8, 17, 1997, 8, 19, 1997, 8, 31), actual)
+}
+
+func TestRRule_Monthly(t *testing.T) {
+	actual := occurrencesOf(t, NewUTCDateTime(1997, 9, 5, 9, 0, 0, 0), "FREQ=MONTHLY;COUNT=10;BYDAY=1FR", 100)
+	assert.Equal(
+		t,
+		utcDays(1997, 9, 5, 1997, 10, 3, 1997, 11, 7, 1997, 12, 5, 1998, 1, 2, 1998, 2, 6, 1998, 3, 6, 1998, 4, 3, 1998, 5, 1, 1998, 6, 5),
+		actual,
+	)
+
+	// Months without the day of month are skipped
+	actual = occurrencesOf(t, NewUTCDateTime(2012, 1, 31, 9, 0, 0, 0), "FREQ=MONTHLY;COUNT=4", 100)
+	assert.Equal(t, utcDays(2012, 1, 31, 2012, 3, 31, 2012, 5, 31, 2012, 7, 31), actual)
+
+	actual = occurrencesOf(t, NewUTCDateTime(2012, 1, 1, 9, 0, 0, 0), "FREQ=MONTHLY;BYMONTHDAY=-1", 3)
+	assert.Equal(t, utcDays(2012, 1, 31, 2012, 2, 29, 2012, 3, 31), actual)
+
+	// Friday the 13th
+	actual = occurrencesOf(t, NewUTCDateTime(1997, 9, 2, 9, 0, 0, 0), "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13", 5)
+	assert.Equal(t, utcDays(1998, 2, 13, 1998, 3, 13, 1998, 11, 13, 1999, 8, 13, 2000, 10, 13), actual)
+
+	// Last workday of the month
+	actual = occurrencesOf(t, NewUTCDateTime(1997, 9, 29, 9, 0, 0, 0), "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", 6)
+	assert.Equal(t, utcDays(1997, 9, 30, 1997, 10, 31, 1997, 11, 28, 1997, 12, 31, 1998, 1, 30, 1998, 2, 27), actual)
+
+	// Third tuesday, wednesday or thursday of the month
+	actual = occurrencesOf(t, NewUTCDateTime(1997, 9, 4, 9, 0, 0, 0), "FREQ=MONTHLY;COUNT=3;BYDAY=TU,WE,TH;BYSETPOS=3", 100)
+	assert.Equal(t, utcDays(1997, 9, 4, 1997, 10, 7, 1997, 11, 6), actual)
+}
+
+func TestRRule_Yearly(t *testing.T) {
+	actual := occurrencesOf(t, NewUTCDateTime(2012, 2, 29, 9, 0, 0, 0), "FREQ=YEARLY;COUNT=3", 100)
+	assert.Equal(t, utcDays(2012, 2, 29, 2016, 2, 29, 2020, 2, 29), actual)
+
+	actual = occurrencesOf(t, NewUTCDateTime(1997, 5, 19, 9, 0, 0, 0), "FREQ=YEARLY;BYDAY=20MO", 3)
+	assert.Equal(t, utcDays(1997, 5, 19, 1998, 5, 18, 1999, 5, 17), actual)
+
+	actual = occurrencesOf(t, NewUTCDateTime(1997, 3, 13, 9, 0, 0, 0), "FREQ=YEARLY;BYMONTH=3;BYDAY=TH", 5)
+	assert.Equal(t, utcDays(1997, 3, 13, 1997, 3, 20, 1997, 3, 27, 1998, 3, 5, 1998, 3, 12), actual)
+
+	// Thanksgiving
+	actual = occurrencesOf(t, NewUTCDateTime(2020, 1, 1, 9, 0, 0, 0), "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH", 3)
+	assert.Equal(t, utcDays(2020, 11, 26, 2021, 11, 25, 2022, 11, 24), actual)
+
+	actual = occurrencesOf(t, NewUTCDateTime(1997, 6, 10, 9, 0, 0, 0), "FREQ=YEARLY;COUNT=4;BYMONTH=6,7", 100)
+	assert.Equal(t, utcDays(1997, 6, 10, 1997, 7, 10, 1998, 6, 10, 1998, 7, 10), actual)
+}
+
+func TestRRule_Clock(t *testing.T) {
+	actual := occurrencesOf(
+		t,
+		NewUTCDateTime(1997, 9, 2, 9, 0, 0, 0),
+		"FREQ=MINUTELY;INTERVAL=20;BYHOUR=9,10,11,12,13,14,15,16",
+		25,
+	)
+	assert.Equal(t, NewUTCDateTime(1997, 9, 2, 9, 20, 0, 0), actual[1])
+	assert.Equal(t, NewUTCDateTime(1997, 9, 2, 16, 40, 0, 0), actual[23])
+	assert.Equal(t, NewUTCDateTime(1997, 9, 3, 9, 0, 0, 0), actual[24])
+
+	actual = occurrencesOf(t, NewUTCDateTime(2012, 12, 12, 9, 0, 0, 0), "FREQ=HOURLY;INTERVAL=3;BYMINUTE=0,30;COUNT=4", 100)
+	assert.Equal(
+		t,
+		[]DateTime{
+			NewUTCDateTime(2012, 12, 12, 9, 0, 0, 0),
+			NewUTCDateTime(2012, 12, 12, 9, 30, 0, 0),
+			NewUTCDateTime(2012, 12, 12, 12, 0, 0, 0),
+			NewUTCDateTime(2012, 12, 12, 12, 30, 0, 0),
+		},
+		actual,
+	)
+
+	// Hourly occurrences count elapsed hours, so the repeated hour occurs twice
+	actual = occurrencesOf(t, NewDateTime(2012, 10, 28, 0, 30, 0, 0, EuropeBerlin), "FREQ=HOURLY;COUNT=4", 100)
+	hours := make([]int, len(actual))
+	for i, occurrence := range actual {
+		hours[i] = occurrence.Hour()
+	}
+	assert.Equal(t, []int{0, 1, 2, 2}, hours)
+	assert.Equal(t, 3*time.Hour, actual[3].Time().Sub(actual[0].Time()))
+
+	actual = occurrencesOf(t, NewUTCDateTime(2012, 1, 1, 0, 0, 0, 0), "FREQ=SECONDLY;BYMONTH=2;BYHOUR=5;BYMINUTE=7;BYSECOND=30", 2)
+	assert.Equal(t, []DateTime{NewUTCDateTime(2012, 2, 1, 5, 7, 30, 0), NewUTCDateTime(2012, 2, 2, 5, 7, 30, 0)}, actual)
+}
+
+func TestRRule_NeverMatching(t *testing.T) {
+	actual := occurrencesOf(t, NewUTCDateTime(2012, 1, 1, 9, 0, 0, 0), "FREQ=MONTHLY;BYMONTH=2;BYMONTHDAY=30", 1)
+	assert.Empty(t, actual)
+}
+
+func TestParseRecurrence(t *testing.T) {
+	actual, err := ParseRecurrence(
+		"DTSTART;TZID=Europe/Berlin:20121212T090000\r\n" +
+			"RRULE:FREQ=WEEKLY;BYDAY=MO,WE;\r\n UNTIL=20121231T090000\r\n" +
+			"EXDATE;TZID=Europe/Berlin:20121217T090000,20121219T090000\r\n" +
+			"RDATE:20121222T100000Z\r\n",
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2012, 12, 12, 9, 0, 0, 0, EuropeBerlin), actual.Start)
+	assert.Equal(t, NewDateTime(2012, 12, 31, 9, 0, 0, 0, EuropeBerlin), actual.Rules[0].Until)
+	assert.Equal(
+		t,
+		[]DateTime{
+			NewDateTime(2012, 12, 12, 9, 0, 0, 0, EuropeBerlin),
+			NewDateTime(2012, 12, 22, 11, 0, 0, 0, EuropeBerlin),
+			NewDateTime(2012, 12, 24, 9, 0, 0, 0, EuropeBerlin),
+			NewDateTime(2012, 12, 26, 9, 0, 0, 0, EuropeBerlin),
+			NewDateTime(2012, 12, 31, 9, 0, 0, 0, EuropeBerlin),
+		},
+		slices.Collect(actual.All()),
+	)
+
+	for _, value := range []string{
+		"RRULE:FREQ=DAILY",
+		"DTSTART:tomorrow",
+		"DTSTART;TZID=Mars/Olympus:20121212T090000",
+		"DTSTART:20121212T090000Z\nDTEND:20121212T100000Z",
+		"DTSTART:20121212T090000Z\nRDATE",
+	} {
+		_, err = ParseRecurrence(value)
+		assert.Error(t, err, value)
+	}
+
+	_, err = ParseRecurrence("DTSTART:20121212T090000Z\nRRULE:FREQ=SOMETIMES")
+	assert.Equal(t, RRuleIsNotParsable("FREQ=SOMETIMES"), err)
+}
+
+func TestRecurrence_String(t *testing.T) {
+	value := "DTSTART;TZID=Europe/Berlin:20121212T090000\n" +
+		"RRULE:FREQ=WEEKLY;COUNT=4;BYDAY=MO,WE\n" +
+		"RDATE;TZID=Europe/Berlin:20121222T110000\n" +
+		"EXDATE;TZID=Europe/Berlin:20121217T090000"
+	recurrence, err := ParseRecurrence(value)
+	assert.NoError(t, err)
+	assert.Equal(t, value, recurrence.String())
+
+	recurrence = NewRecurrence(NewDateTime(2012, 12, 12, 9, 0, 0, 0, AsiaKathmandu), NewRRule(FrequencyDaily))
+	recurrence.Start = DateTimeFromTime(recurrence.Start.Time().In(time.FixedZone("", 3600)))
+	assert.Equal(t, "DTSTART:20121212T031500Z\nRRULE:FREQ=DAILY", recurrence.String())
+}
+
+func TestRecurrence_Between(t *testing.T) {
+	recurrence := NewRecurrence(NewUTCDateTime(2012, 12, 1, 9, 0, 0, 0), NewRRule(FrequencyDaily))
+	recurrence.ExDates = []DateTime{NewUTCDateTime(2012, 12, 13, 9, 0, 0, 0)}
+	recurrence.RDates = []DateTime{NewUTCDateTime(2012, 12, 12, 9, 0, 0, 0), NewUTCDateTime(2012, 12, 12, 18, 0, 0, 0)}
+
+	actual := recurrence.Between(NewUTCDateTime(2012, 12, 12, 0, 0, 0, 0), NewUTCDateTime(2012, 12, 14, 9, 0, 0, 0))
+	assert.Equal(
+		t,
+		[]DateTime{
+			NewUTCDateTime(2012, 12, 12, 9, 0, 0, 0),
+			NewUTCDateTime(2012, 12, 12, 18, 0, 0, 0),
+			NewUTCDateTime(2012, 12, 14, 9, 0, 0, 0),
+		},
+		actual,
+	)
+}
+
+func TestRecurrence_Next(t *testing.T) {
+	rule := NewRRule(FrequencyMonthly)
+	rule.ByDay = []NthWeekday{{Weekday: time.Friday, N: -1}}
+	rule.Count = 2
+	recurrence := NewRecurrence(NewUTCDateTime(2012, 12, 1, 9, 0, 0, 0), rule)
+
+	actual, ok := recurrence.Next(NewUTCDateTime(2012, 12, 28, 9, 0, 0, 0))
+	assert.True(t, ok)
+	assert.Equal(t, NewUTCDateTime(2013, 1, 25, 9, 0, 0, 0), actual)
+
+	_, ok = recurrence.Next(actual)
+	assert.False(t, ok)
+}
@@ -0,0 +1,257 @@
+package gostradamus
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// strftimeFormatTokens are the strftime directives with an equivalent FormatToken
+	strftimeFormatTokens = map[string]FormatToken{
+		"a":  DayOfWeekAbbr,
+		"A":  DayOfWeekFullName,
+		"d":  DayOfMonthZeroPadded,
+		"b":  MonthAbbr,
+		"h":  MonthAbbr,
+		"B":  MonthFull,
+		"m":  MonthZeroPadded,
+		"y":  YearShort,
+		"Y":  YearFull,
+		"H":  TwentyFourHourZeroPadded,
+		"I":  TwelveHourZeroPadded,
+		"p":  AMPMUpper,
+		"M":  MinuteZeroPadded,
+		"S":  SecondZeroPadded,
+		"f":  MicroSecond,
+		"z":  TimezoneWithoutColon,
+		":z": TimezoneWithColon,
+		"Z":  TimezoneFullName,
+		"j":  DayOfYearZeroPadded,
+		"G":  IsoWeekYear,
+		"V":  IsoWeekOfYearZeroPadded,
+	}
+
+	// strftimeInternalTokens are the strftime directives, which are parsed with internal FormatTokens
+	strftimeInternalTokens = map[string]FormatToken{
+		"U": strptimeSundayWeek,
+		"W": strptimeMondayWeek,
+		"u": strptimeIsoWeekday,
+		"w": strptimeWeekday,
+		"e": strptimeDay,
+	}
+
+	// strftimeCombinations are the strftime directives, which combine other directives like in the C locale
+	strftimeCombinations = map[string]string{
+		"c": "%a %b %e %H:%M:%S %Y",
+		"D": "%m/%d/%y",
+		"x": "%m/%d/%y",
+		"F": "%Y-%m-%d",
+		"T": "%H:%M:%S",
+		"X": "%H:%M:%S",
+		"R": "%H:%M",
+		"r": "%I:%M:%S %p",
+	}
+
+	// strftimeLiterals are the strftime directives of literals
+	strftimeLiterals = map[string]string{
+		"%": "%",
+		"n": "\n",
+		"t": "\t",
+	}
+
+	// formatTokenDirectives are the strftime directives of FormatTokens
+	formatTokenDirectives = map[FormatToken]string{
+		DayOfWeekAbbr:            "%a",
+		DayOfWeekFullName:        "%A",
+		DayOfMonthZeroPadded:     "%d",
+		MonthAbbr:                "%b",
+		MonthFull:                "%B",
+		MonthZeroPadded:          "%m",
+		YearShort:                "%y",
+		YearFull:                 "%Y",
+		TwentyFourHourZeroPadded: "%H",
+		TwelveHourZeroPadded:     "%I",
+		AMPMUpper:                "%p",
+		MinuteZeroPadded:         "%M",
+		SecondZeroPadded:         "%S",
+		MicroSecond:              "%f",
+		TimezoneWithoutColon:     "%z",
+		TimezoneWithColon:        "%:z",
+		TimezoneFullName:         "%Z",
+		DayOfYearZeroPadded:      "%j",
+		IsoWeekYear:              "%G",
+		IsoWeekOfYearZeroPadded:  "%V",
+	}
+)
+
+// FormatStrftime the current DateTime with given strftime format to a string, like Python and C in the C locale.
+// Supported directives are %a %A %w %u %d %e %b %h %B %m %y %Y %H %I %p %M %S %f %z %:z %Z %j %U %W %G %V,
+// the combinations %c %D %x %F %T %X %R %r and the literals %% %n %t.
+// Unknown directives are kept as they are.
+//
+// For Example:
+//
+//	"%Y-%m-%d %H:%M:%S %z" becomes "2011-04-05 15:07:08 +0000"
+func (dt DateTime) FormatStrftime(format string) string {
+	value := dt.Time()
+	elements, _ := compileStrftime(format)
+
+	var builder strings.Builder
+	for _, element := range elements {
+		if element.token == "" {
+			builder.WriteString(element.literal)
+			continue
+		}
+		builder.WriteString(formatStrftimeToken(value, element.token))
+	}
+	return builder.String()
+}
+
+// ParseStrptime a string value with given strftime format into a new DateTime, like Python's strptime.
+// The directives of FormatStrftime are supported. Weeks of %U, %W and %V without weekday are their first day.
+// Like in Python, numbers may omit leading zeros, %f has 1 to 6 digits, %z may have a colon, like "+01:00",
+// and names ignore the case, see ParseModeLenient.
+func ParseStrptime(value string, format string) (DateTime, error) {
+	return ParseStrptimeInTimezone(value, format, UTC)
+}
+
+// ParseStrptimeInTimezone a string value with given strftime format into a new DateTime in given timezone
+func ParseStrptimeInTimezone(value string, format string, timezone Timezone) (DateTime, error) {
+	elements, err := compileStrftime(format)
+	if err != nil {
+		return DateTime{}, err
+	}
+	compiled := compiledFormat{format: format, elements: elements}
+	parsedTime, err := compiled.parse(value, timezone.Location(), EnglishLocale, ParseModeLenient)
+	return DateTimeFromTime(parsedTime), err
+}
+
+// StrftimeToFormat converts a strftime format to a gostradamus format
+//
+// For Example:
+//
+//	"%d.%m.%Y %H:%M" becomes "DD.MM.YYYY HH:mm"
+//	"%H:%M at %d.%m." becomes "HH:mm[ at ]DD.MM."
+//
+// Consider that %z becomes Z, which formats UTC as "Z" instead of "+0000".
+// error if a directive has no equivalent FormatToken, like %U, %W, %u, %w and %e
+func StrftimeToFormat(strftime string) (string, error) {
+	elements, err := compileStrftime(strftime)
+	if err != nil {
+		return "", err
+	}
+	for _, element := range elements {
+		if _, ok := formatTokenDirectives[element.token]; element.token != "" && !ok {
+			return "", DirectiveIsNotConvertible(string(element.token))
+		}
+	}
+	return formatFromElements(elements), nil
+}
+
+// FormatToStrftime converts a gostradamus format to a strftime format.
+// Localized FormatTokens are replaced by the formats of EnglishLocale.
+//
+// For Example:
+//
+//	"DD.MM.YYYY HH:mm" becomes "%d.%m.%Y %H:%M"
+//
+// error if a FormatToken has no equivalent directive, like Do, M or D
+func FormatToStrftime(format string) (string, error) {
+	var builder strings.Builder
+	for _, element := range compileLayout(format, defaultLocale()) {
+		if element.token == "" {
+			builder.WriteString(strings.ReplaceAll(element.literal, "%", "%%"))
+			continue
+		}
+		directive, ok := formatTokenDirectives[element.token]
+		if !ok {
+			return "", FormatTokenIsNotConvertible(string(element.token))
+		}
+		builder.WriteString(directive)
+	}
+	return builder.String(), nil
+}
+
+// compileStrftime splits a strftime format into FormatTokens and the literals between them.
+// error if a directive is not supported, which is kept as literal
+func compileStrftime(format string) ([]layoutElement, error) {
+	var elements []layoutElement
+	var err error
+	literal := func(value string) {
+		if last := len(elements) - 1; last >= 0 && elements[last].token == "" {
+			elements[last].literal += value
+			return
+		}
+		elements = append(elements, layoutElement{literal: value})
+	}
+
+	for format != "" {
+		index := strings.IndexByte(format, '%')
+		if index < 0 {
+			literal(format)
+			break
+		}
+		if index > 0 {
+			literal(format[:index])
+		}
+		directive := format[index+1:]
+		length := 1
+		if strings.HasPrefix(directive, ":") {
+			length = 2
+		}
+		if len(directive) < length {
+			literal(format[index:])
+			if err == nil {
+				err = DirectiveIsNotSupported(format[index:])
+			}
+			break
+		}
+		name := directive[:length]
+		format = directive[length:]
+
+		if token, ok := strftimeFormatTokens[name]; ok {
+			elements = append(elements, layoutElement{token: token})
+		} else if token, ok := strftimeInternalTokens[name]; ok {
+			elements = append(elements, layoutElement{token: token})
+		} else if combination, ok := strftimeCombinations[name]; ok {
+			combined, _ := compileStrftime(combination)
+			for _, element := range combined {
+				if element.token == "" {
+					literal(element.literal)
+				} else {
+					elements = append(elements, element)
+				}
+			}
+		} else if value, ok := strftimeLiterals[name]; ok {
+			literal(value)
+		} else {
+			literal("%" + name)
+			if err == nil {
+				err = DirectiveIsNotSupported("%" + name)
+			}
+		}
+	}
+	return elements, err
+}
+
+// formatStrftimeToken formats value as given FormatToken of a strftime directive
+func formatStrftimeToken(value time.Time, token FormatToken) string {
+	switch token {
+	case strptimeSundayWeek:
+		return fmt.Sprintf("%02d", (value.YearDay()+WeekInDays-1-int(value.Weekday()))/WeekInDays)
+	case strptimeMondayWeek:
+		return fmt.Sprintf("%02d", (value.YearDay()+WeekInDays-1-daysSinceWeekday(value.Weekday(), time.Monday))/WeekInDays)
+	case strptimeIsoWeekday:
+		return fmt.Sprint(daysSinceWeekday(value.Weekday(), time.Monday) + 1)
+	case strptimeWeekday:
+		return fmt.Sprint(int(value.Weekday()))
+	case strptimeDay:
+		return fmt.Sprintf("%2d", value.Day())
+	case TimezoneWithoutColon:
+		return value.Format(string(GoNumTZ))
+	case TimezoneWithColon:
+		return value.Format(string(GoNumColonTZ))
+	}
+	return formatToken(value, token, EnglishLocale)
+}
@@ -0,0 +1,170 @@
+package gostradamus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateTime_FormatStrftime(t *testing.T) {
+	dateTime := NewDateTime(2011, 4, 5, 15, 7, 8, 123456000, UTC)
+	tests := map[string]string{
+		"%Y-%m-%d %H:%M:%S %z": "2011-04-05 15:07:08 +0000",
+		"%a %A %b %h %B":       "Tue Tuesday Apr Apr April",
+		"%y %I %p %f %Z %:z":   "11 03 PM 123456 UTC +00:00",
+		"%j %U %W %G-W%V-%u":   "095 14 14 2011-W14-2",
+		"%w %e":                "2  5",
+		"%c":                   "Tue Apr  5 15:07:08 2011",
+		"%D %x %F %T %X %R %r": "04/05/11 04/05/11 2011-04-05 15:07:08 15:07:08 15:07 03:07:08 PM",
+		"100%%%n%t":            "100%\n\t",
+		"%Q %":                 "%Q %",
+	}
+	for format, expected := range tests {
+		assert.Equal(t, expected, dateTime.FormatStrftime(format), format)
+	}
+
+	assert.Equal(
+		t,
+		"+0545 +05:45",
+		NewDateTime(2011, 4, 5, 15, 7, 8, 0, AsiaKathmandu).FormatStrftime("%z %:z"),
+	)
+}
+
+func TestDateTime_FormatStrftime_Weeks(t *testing.T) {
+	// 2012-01-01 is a sunday, 2012-12-31 a monday in ISO week 1 of 2013
+	tests := map[DateTime]string{
+		NewDateTime(2012, 1, 1, 0, 0, 0, 0, UTC):   "01 00 2011-52 7 0",
+		NewDateTime(2012, 1, 2, 0, 0, 0, 0, UTC):   "01 01 2012-01 1 1",
+		NewDateTime(2012, 12, 31, 0, 0, 0, 0, UTC): "53 53 2013-01 1 1",
+		NewDateTime(2011, 1, 1, 0, 0, 0, 0, UTC):   "00 00 2010-52 6 6",
+	}
+	for dateTime, expected := range tests {
+		assert.Equal(t, expected, dateTime.FormatStrftime("%U %W %G-%V %u %w"), dateTime.String())
+	}
+}
+
+func TestParseStrptime(t *testing.T) {
+	tests := []struct {
+		value    string
+		format   string
+		expected DateTime
+	}{
+		{"2011-04-05 15:07:08 +0000", "%Y-%m-%d %H:%M:%S %z", NewDateTime(2011, 4, 5, 15, 7, 8, 0, UTC)},
+		{"2011-04-05T15:07:08.123456Z", "%Y-%m-%dT%H:%M:%S.%f%z", NewDateTime(2011, 4, 5, 15, 7, 8, 123456000, UTC)},
+		{"tuesday, 5 april 11 3:07 pm", "%A, %d %B %y %I:%M %p", NewDateTime(2011, 4, 5, 15, 7, 0, 0, UTC)},
+		{"Tue Apr  5 15:07:08 2011", "%c", NewDateTime(2011, 4, 5, 15, 7, 8, 0, UTC)},
+		{"2011 095", "%Y %j", NewDateTime(2011, 4, 5, 0, 0, 0, 0, UTC)},
+		{"2011-W14-2", "%G-W%V-%u", NewDateTime(2011, 4, 5, 0, 0, 0, 0, UTC)},
+		{"2013-W01", "%G-W%V", NewDateTime(2012, 12, 31, 0, 0, 0, 0, UTC)},
+		{"2011 14 2", "%Y %U %w", NewDateTime(2011, 4, 5, 0, 0, 0, 0, UTC)},
+		{"2011 14 2", "%Y %W %u", NewDateTime(2011, 4, 5, 0, 0, 0, 0, UTC)},
+		{"2011 00 6", "%Y %U %w", NewDateTime(2011, 1, 1, 0, 0, 0, 0, UTC)},
+		{"2011 14", "%Y %W", NewDateTime(2011, 4, 4, 0, 0, 0, 0, UTC)},
+		{"5%", "%d%%", NewDateTime(0, 1, 5, 0, 0, 0, 0, UTC)},
+		{"12:00:00.5", "%H:%M:%S.%f", NewDateTime(0, 1, 1, 12, 0, 0, 500000000, UTC)},
+		{"12:00:00.012", "%H:%M:%S.%f", NewDateTime(0, 1, 1, 12, 0, 0, 12000000, UTC)},
+	}
+	for _, test := range tests {
+		actual, err := ParseStrptime(test.value, test.format)
+		assert.NoError(t, err, test.value)
+		assert.Equal(t, test.expected, actual, test.value)
+	}
+}
+
+func TestParseStrptime_Offset(t *testing.T) {
+	expected := NewDateTime(2011, 4, 5, 14, 7, 0, 0, UTC)
+	for _, value := range []string{"2011-04-05 15:07 +01:00", "2011-04-05 15:07 +0100"} {
+		for _, format := range []string{"%Y-%m-%d %H:%M %z", "%Y-%m-%d %H:%M %:z"} {
+			actual, err := ParseStrptime(value, format)
+			assert.NoError(t, err, value)
+			assert.True(t, expected.Time().Equal(actual.Time()), value)
+		}
+	}
+}
+
+func TestParseStrptime_RoundTrip(t *testing.T) {
+	format := "%a %d %b %Y %H:%M:%S.%f %z %j %G-%V-%u"
+	for _, dateTime := range []DateTime{
+		NewDateTime(2011, 4, 5, 15, 7, 8, 123456000, UTC),
+		NewDateTime(2012, 12, 31, 23, 59, 59, 999999000, UTC),
+		NewDateTime(2020, 2, 29, 0, 0, 0, 0, UTC),
+	} {
+		actual, err := ParseStrptime(dateTime.FormatStrftime(format), format)
+		assert.NoError(t, err, dateTime.String())
+		assert.Equal(t, dateTime, actual)
+	}
+}
+
+func TestParseStrptimeInTimezone(t *testing.T) {
+	actual, err := ParseStrptimeInTimezone("2011-04-05 15:07", "%Y-%m-%d %H:%M", EuropeBerlin)
+	assert.NoError(t, err)
+	assert.Equal(t, NewDateTime(2011, 4, 5, 15, 7, 0, 0, EuropeBerlin), actual)
+}
+
+func TestParseStrptime_Error(t *testing.T) {
+	_, err := ParseStrptime("2011", "%Y %Q")
+	assert.EqualError(t, err, "Directive: %Q is not supported")
+
+	_, err = ParseStrptime("2011", "%Y%")
+	assert.EqualError(t, err, "Directive: % is not supported")
+
+	_, err = ParseStrptime("2011-W54", "%G-W%V")
+	assert.EqualError(t, err, `Value: "2011-W54" is not parsable as "%G-W%V", ISO week 54 is out of range`)
+
+	_, err = ParseStrptime("2011 54", "%Y %U")
+	assert.EqualError(t, err, `Value: "2011 54" is not parsable as "%Y %U", week 54 is out of range`)
+
+	_, err = ParseStrptime("2011 8", "%Y %u")
+	assert.Error(t, err)
+
+	_, err = ParseStrptime("2011-04-05 2011-W13", "%Y-%m-%d %G-W%V")
+	assert.EqualError(
+		t,
+		err,
+		`Value: "2011-04-05 2011-W13" is not parsable as "%Y-%m-%d %G-W%V", week 13 does not match the month and day`,
+	)
+}
+
+func TestStrftimeToFormat(t *testing.T) {
+	tests := map[string]string{
+		"%d.%m.%Y %H:%M":           "DD.MM.YYYY HH:mm",
+		"%Y-%m-%dT%H:%M:%S.%f%z":   "YYYY-MM-DDTHH:mm:ss.SZ",
+		"%H:%M at %d.%m.":          "HH:mm[ at ]DD.MM.",
+		"%m%b":                     "MM[]MMM",
+		"[%d] 100%%":               "[[]DD] 100%",
+		"%F %T":                    "YYYY-MM-DD HH:mm:ss",
+		"%a, %d %b %Y %H:%M:%S %Z": "ddd, DD MMM YYYY HH:mm:ss ZZZ",
+	}
+	for strftime, expected := range tests {
+		actual, err := StrftimeToFormat(strftime)
+		assert.NoError(t, err, strftime)
+		assert.Equal(t, expected, actual, strftime)
+	}
+
+	_, err := StrftimeToFormat("%Y %U")
+	assert.EqualError(t, err, "Directive: %U is not convertible")
+
+	_, err = StrftimeToFormat("%c")
+	assert.EqualError(t, err, "Directive: %e is not convertible")
+
+	_, err = StrftimeToFormat("%Q")
+	assert.EqualError(t, err, "Directive: %Q is not supported")
+}
+
+func TestFormatToStrftime(t *testing.T) {
+	tests := map[string]string{
+		"DD.MM.YYYY HH:mm":         "%d.%m.%Y %H:%M",
+		"YYYY-MM-DDTHH:mm:ss.Szz":  "%Y-%m-%dT%H:%M:%S.%f%:z",
+		"[Week] GGGG-WW 100%":      "Week %G-%V 100%%",
+		"ddd, DD MMM YYYY hh:mm A": "%a, %d %b %Y %I:%M %p",
+		"L":                        "%m/%d/%Y",
+	}
+	for format, expected := range tests {
+		actual, err := FormatToStrftime(format)
+		assert.NoError(t, err, format)
+		assert.Equal(t, expected, actual, format)
+	}
+
+	_, err := FormatToStrftime("Do MMMM")
+	assert.EqualError(t, err, "FormatToken: Do is not convertible")
+}
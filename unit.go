@@ -0,0 +1,242 @@
+package gostradamus
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Unit is a calendar or clock unit a DateTime can be floored, ceiled, rounded or shifted by
+type Unit int
+
+// All Units ordered from the smallest to the largest
+const (
+	UnitNanosecond Unit = iota
+	UnitMicrosecond
+	UnitMillisecond
+	UnitSecond
+	UnitMinute
+	UnitHour
+	UnitDay
+	UnitWeek
+	UnitMonth
+	UnitQuarter
+	UnitHalfYear
+	UnitYear
+	UnitDecade
+	UnitCentury
+)
+
+var (
+	unitNames = map[Unit]string{
+		UnitNanosecond:  "nanosecond",
+		UnitMicrosecond: "microsecond",
+		UnitMillisecond: "millisecond",
+		UnitSecond:      "second",
+		UnitMinute:      "minute",
+		UnitHour:        "hour",
+		UnitDay:         "day",
+		UnitWeek:        "week",
+		UnitMonth:       "month",
+		UnitQuarter:     "quarter",
+		UnitHalfYear:    "halfyear",
+		UnitYear:        "year",
+		UnitDecade:      "decade",
+		UnitCentury:     "century",
+	}
+
+	unitAliases = map[string]Unit{
+		"ns":         UnitNanosecond,
+		"us":         UnitMicrosecond,
+		"µs":         UnitMicrosecond,
+		"ms":         UnitMillisecond,
+		"s":          UnitSecond,
+		"sec":        UnitSecond,
+		"min":        UnitMinute,
+		"h":          UnitHour,
+		"d":          UnitDay,
+		"w":          UnitWeek,
+		"q":          UnitQuarter,
+		"half-year":  UnitHalfYear,
+		"half_year":  UnitHalfYear,
+		"half-years": UnitHalfYear,
+		"half_years": UnitHalfYear,
+		"y":          UnitYear,
+		"centuries":  UnitCentury,
+	}
+)
+
+// ParseUnit returns the Unit with the given name.
+// Names are case-insensitive and can be singular or plural, like "day" or "Days".
+// Common abbreviations like "ms", "h" or "d" are accepted as well.
+func ParseUnit(name string) (Unit, error) {
+	normalizedName := strings.ToLower(strings.TrimSpace(name))
+	if unit, ok := unitAliases[normalizedName]; ok {
+		return unit, nil
+	}
+	for unit, unitName := range unitNames {
+		if normalizedName == unitName || normalizedName == unitName+"s" {
+			return unit, nil
+		}
+	}
+	return 0, UnitNameIsUnknown(name)
+}
+
+// String returns the name of the Unit
+// Example: "day"
+func (u Unit) String() string {
+	if name, ok := unitNames[u]; ok {
+		return name
+	}
+	return fmt.Sprintf("Unit(%d)", int(u))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+func (u Unit) MarshalText() ([]byte, error) {
+	if _, ok := unitNames[u]; !ok {
+		return nil, UnitIsNotSupported(u)
+	}
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface
+func (u *Unit) UnmarshalText(text []byte) error {
+	unit, err := ParseUnit(string(text))
+	if err != nil {
+		return err
+	}
+	*u = unit
+	return nil
+}
+
+// Floor returns the DateTime floored to the start of given unit
+//
+// For Example:
+//
+//	2012-12-12 12:12:12.123456789 floored to UnitMonth becomes 2012-12-01 00:00:00.000000000
+//
+// Floor panics if the unit is not supported
+func (dt DateTime) Floor(unit Unit) DateTime {
+	switch unit {
+	case UnitNanosecond:
+		return dt
+	case UnitMicrosecond:
+		return dt.ReplaceNanosecond(dt.Nanosecond() / int(time.Microsecond) * int(time.Microsecond))
+	case UnitMillisecond:
+		return dt.ReplaceNanosecond(dt.Nanosecond() / int(time.Millisecond) * int(time.Millisecond))
+	case UnitSecond:
+		return dt.FloorSecond()
+	case UnitMinute:
+		return dt.FloorMinute()
+	case UnitHour:
+		return dt.FloorHour()
+	case UnitDay:
+		return dt.FloorDay()
+	case UnitWeek:
+		return dt.FloorWeek()
+	case UnitMonth:
+		return dt.FloorMonth()
+	case UnitQuarter:
+		return dt.FloorQuarter()
+	case UnitHalfYear:
+		return dt.FloorHalfYear()
+	case UnitYear:
+		return dt.FloorYear()
+	case UnitDecade:
+		return dt.FloorDecade()
+	case UnitCentury:
+		return dt.FloorCentury()
+	}
+	panic(UnitIsNotSupported(unit))
+}
+
+// Ceil returns the DateTime ceiled to the last nanosecond of given unit
+//
+// For Example:
+//
+//	2012-12-12 12:12:12.123456789 ceiled to UnitMonth becomes 2012-12-31 23:59:59.999999999
+//
+// Ceil panics if the unit is not supported
+func (dt DateTime) Ceil(unit Unit) DateTime {
+	switch unit {
+	case UnitNanosecond:
+		return dt
+	case UnitMicrosecond:
+		return dt.Floor(UnitMicrosecond).ShiftNanoseconds(int(time.Microsecond) - 1)
+	case UnitMillisecond:
+		return dt.Floor(UnitMillisecond).ShiftNanoseconds(int(time.Millisecond) - 1)
+	case UnitSecond:
+		return dt.CeilSecond()
+	case UnitMinute:
+		return dt.CeilMinute()
+	case UnitHour:
+		return dt.CeilHour()
+	case UnitDay:
+		return dt.CeilDay()
+	case UnitWeek:
+		return dt.CeilWeek()
+	case UnitMonth:
+		return dt.CeilMonth()
+	case UnitQuarter:
+		return dt.CeilQuarter()
+	case UnitHalfYear:
+		return dt.CeilHalfYear()
+	case UnitYear:
+		return dt.CeilYear()
+	case UnitDecade:
+		return dt.CeilDecade()
+	case UnitCentury:
+		return dt.CeilCentury()
+	}
+	panic(UnitIsNotSupported(unit))
+}
+
+// Span returns the start and end DateTime of current span of given unit
+//
+// For Example:
+//
+//	2012-12-12 12:12:12.123456789 with UnitMonth becomes (2012-12-01 00:00:00.000000000, 2012-12-31 23:59:59.999999999)
+//
+// Span panics if the unit is not supported
+func (dt DateTime) Span(unit Unit) (DateTime, DateTime) {
+	return dt.Floor(unit), dt.Ceil(unit)
+}
+
+// ShiftBy adds or subtracts amount times the given unit
+// Add is a positive integer
+// Subtract is a negative integer
+//
+// ShiftBy panics if the unit is not supported
+func (dt DateTime) ShiftBy(unit Unit, amount int) DateTime {
+	switch unit {
+	case UnitNanosecond:
+		return dt.ShiftNanoseconds(amount)
+	case UnitMicrosecond:
+		return dt.ShiftMicroSeconds(amount)
+	case UnitMillisecond:
+		return dt.ShiftMilliSeconds(amount)
+	case UnitSecond:
+		return dt.ShiftSeconds(amount)
+	case UnitMinute:
+		return dt.ShiftMinutes(amount)
+	case UnitHour:
+		return dt.ShiftHours(amount)
+	case UnitDay:
+		return dt.ShiftDays(amount)
+	case UnitWeek:
+		return dt.ShiftWeeks(amount)
+	case UnitMonth:
+		return dt.ShiftMonths(amount)
+	case UnitQuarter:
+		return dt.ShiftQuarters(amount)
+	case UnitHalfYear:
+		return dt.ShiftHalfYears(amount)
+	case UnitYear:
+		return dt.ShiftYears(amount)
+	case UnitDecade:
+		return dt.ShiftDecades(amount)
+	case UnitCentury:
+		return dt.ShiftCenturies(amount)
+	}
+	panic(UnitIsNotSupported(unit))
+}
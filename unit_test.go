@@ -0,0 +1,111 @@
+package gostradamus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateTime_Floor(t *testing.T) {
+	dateTime := NewUTCDateTime(2012, 12, 12, 12, 12, 12, 123456789)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 12, 12, 123456000), dateTime.Floor(UnitMicrosecond))
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 12, 12, 123000000), dateTime.Floor(UnitMillisecond))
+	assert.Equal(t, dateTime.FloorWeek(), dateTime.Floor(UnitWeek))
+}
+
+func TestDateTime_Ceil(t *testing.T) {
+	dateTime := NewUTCDateTime(2012, 12, 12, 12, 12, 12, 123456789)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 12, 12, 123456999), dateTime.Ceil(UnitMicrosecond))
+	assert.Equal(t, NewUTCDateTime(2012, 12, 12, 12, 12, 12, 123999999), dateTime.Ceil(UnitMillisecond))
+	assert.Equal(t, dateTime.CeilMonth(), dateTime.Ceil(UnitMonth))
+}
+
+func TestDateTime_Span(t *testing.T) {
+	start, end := NewUTCDateTime(2012, 12, 12, 12, 12, 12, 123456789).Span(UnitQuarter)
+	assert.Equal(t, NewUTCDateTime(2012, 10, 1, 0, 0, 0, 0), start)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 31, 23, 59, 59, 999999999), end)
+
+	assert.PanicsWithError(
+		t,
+		"Unit: -1 is not supported",
+		func() {
+			NewUTCDateTime(2012, 12, 12, 12, 12, 12, 0).Span(Unit(-1))
+		},
+	)
+}
+
+func TestDateTime_ShiftBy(t *testing.T) {
+	dateTime := NewUTCDateTime(2012, 1, 31, 12, 0, 0, 0)
+	assert.Equal(t, NewUTCDateTime(2012, 1, 31, 12, 0, 0, 3), dateTime.ShiftBy(UnitNanosecond, 3))
+	assert.Equal(t, NewUTCDateTime(2012, 1, 31, 12, 0, 0, 3000), dateTime.ShiftBy(UnitMicrosecond, 3))
+	assert.Equal(t, NewUTCDateTime(2012, 1, 31, 12, 0, 0, 3000000), dateTime.ShiftBy(UnitMillisecond, 3))
+	assert.Equal(t, NewUTCDateTime(2012, 1, 31, 12, 0, 3, 0), dateTime.ShiftBy(UnitSecond, 3))
+	assert.Equal(t, NewUTCDateTime(2012, 1, 31, 11, 57, 0, 0), dateTime.ShiftBy(UnitMinute, -3))
+	assert.Equal(t, NewUTCDateTime(2012, 1, 31, 15, 0, 0, 0), dateTime.ShiftBy(UnitHour, 3))
+	assert.Equal(t, NewUTCDateTime(2012, 2, 3, 12, 0, 0, 0), dateTime.ShiftBy(UnitDay, 3))
+	assert.Equal(t, NewUTCDateTime(2012, 2, 21, 12, 0, 0, 0), dateTime.ShiftBy(UnitWeek, 3))
+	assert.Equal(t, NewUTCDateTime(2012, 5, 1, 12, 0, 0, 0), dateTime.ShiftBy(UnitMonth, 3))
+	assert.Equal(t, NewUTCDateTime(2012, 7, 31, 12, 0, 0, 0), dateTime.ShiftBy(UnitQuarter, 2))
+	assert.Equal(t, NewUTCDateTime(2011, 7, 31, 12, 0, 0, 0), dateTime.ShiftBy(UnitHalfYear, -1))
+	assert.Equal(t, NewUTCDateTime(2015, 1, 31, 12, 0, 0, 0), dateTime.ShiftBy(UnitYear, 3))
+	assert.Equal(t, NewUTCDateTime(2042, 1, 31, 12, 0, 0, 0), dateTime.ShiftBy(UnitDecade, 3))
+	assert.Equal(t, NewUTCDateTime(1912, 1, 31, 12, 0, 0, 0), dateTime.ShiftBy(UnitCentury, -1))
+
+	assert.PanicsWithError(
+		t,
+		"Unit: 42 is not supported",
+		func() {
+			dateTime.ShiftBy(Unit(42), 1)
+		},
+	)
+}
+
+func TestParseUnit(t *testing.T) {
+	for name, expected := range map[string]Unit{
+		"nanosecond": UnitNanosecond,
+		"ms":         UnitMillisecond,
+		"Second":     UnitSecond,
+		"minutes":    UnitMinute,
+		" HOURS ":    UnitHour,
+		"d":          UnitDay,
+		"week":       UnitWeek,
+		"months":     UnitMonth,
+		"quarter":    UnitQuarter,
+		"half-year":  UnitHalfYear,
+		"halfyears":  UnitHalfYear,
+		"y":          UnitYear,
+		"decades":    UnitDecade,
+		"centuries":  UnitCentury,
+	} {
+		actual, err := ParseUnit(name)
+		assert.NoError(t, err, name)
+		assert.Equal(t, expected, actual, name)
+	}
+
+	_, err := ParseUnit("fortnight")
+	assert.EqualError(t, err, "Unit: fortnight is unknown")
+}
+
+func TestUnit_String(t *testing.T) {
+	assert.Equal(t, "day", UnitDay.String())
+	assert.Equal(t, "halfyear", UnitHalfYear.String())
+	assert.Equal(t, "Unit(42)", Unit(42).String())
+}
+
+func TestUnit_MarshalText(t *testing.T) {
+	actual, err := UnitWeek.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("week"), actual)
+
+	_, err = Unit(42).MarshalText()
+	assert.EqualError(t, err, "Unit: 42 is not supported")
+}
+
+func TestUnit_UnmarshalText(t *testing.T) {
+	var unit Unit
+	assert.NoError(t, unit.UnmarshalText([]byte("months")))
+	assert.Equal(t, UnitMonth, unit)
+
+	assert.EqualError(t, unit.UnmarshalText([]byte("fortnight")), "Unit: fortnight is unknown")
+	assert.Equal(t, UnitMonth, unit)
+}
@@ -0,0 +1,74 @@
+package gostradamus
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// WeekCalendar defines on which weekday weeks start and how weeks of a year are numbered
+type WeekCalendar struct {
+	// FirstDay is the weekday every week starts on
+	FirstDay time.Weekday
+	// MinDaysInFirstWeek is the minimum count of days of a new year the first week of that year must contain.
+	// Values below 1 are treated as 1, values above 7 as 7.
+	MinDaysInFirstWeek int
+}
+
+var (
+	// IsoWeekCalendar starts weeks on monday and the first week of a year contains its first thursday (ISO-8601)
+	IsoWeekCalendar = WeekCalendar{FirstDay: time.Monday, MinDaysInFirstWeek: 4}
+
+	// SundayWeekCalendar starts weeks on sunday and the first week of a year contains January 1st,
+	// like it is common in North America
+	SundayWeekCalendar = WeekCalendar{FirstDay: time.Sunday, MinDaysInFirstWeek: 1}
+
+	// SaturdayWeekCalendar starts weeks on saturday and the first week of a year contains January 1st,
+	// like it is common in the Middle East
+	SaturdayWeekCalendar = WeekCalendar{FirstDay: time.Saturday, MinDaysInFirstWeek: 1}
+
+	defaultWeekCalendar atomic.Pointer[WeekCalendar]
+)
+
+func init() {
+	SetDefaultWeekCalendar(IsoWeekCalendar)
+}
+
+// DefaultWeekCalendar returns the WeekCalendar used by FloorWeek, CeilWeek, SpanWeek, Week
+// and the week formatting tokens. It is IsoWeekCalendar if not set otherwise.
+func DefaultWeekCalendar() WeekCalendar {
+	return *defaultWeekCalendar.Load()
+}
+
+// SetDefaultWeekCalendar sets the WeekCalendar returned by DefaultWeekCalendar
+func SetDefaultWeekCalendar(weekCalendar WeekCalendar) {
+	defaultWeekCalendar.Store(&weekCalendar)
+}
+
+// Week returns the week-numbering year and the week number of given DateTime in this WeekCalendar.
+// The week-numbering year can differ from the year of the DateTime at the beginning and the end of a year.
+//
+// For Example with IsoWeekCalendar:
+//
+//	2012-12-31 (monday) becomes (2013, 1)
+func (wc WeekCalendar) Week(dateTime DateTime) (int, int) {
+	date := time.Date(dateTime.Year(), time.Month(dateTime.Month()), dateTime.Day(), 0, 0, 0, 0, time.UTC)
+	weekStart := date.AddDate(0, 0, -daysSinceWeekday(date.Weekday(), wc.FirstDay))
+	// the week belongs to the year of the day, after which at least MinDaysInFirstWeek days of the week are left
+	reference := weekStart.AddDate(0, 0, WeekInDays-wc.minDaysInFirstWeek())
+	return reference.Year(), (reference.YearDay()-1)/WeekInDays + 1
+}
+
+func (wc WeekCalendar) minDaysInFirstWeek() int {
+	if wc.MinDaysInFirstWeek < 1 {
+		return 1
+	}
+	if wc.MinDaysInFirstWeek > WeekInDays {
+		return WeekInDays
+	}
+	return wc.MinDaysInFirstWeek
+}
+
+// daysSinceWeekday returns how many days passed since the last given first weekday
+func daysSinceWeekday(weekday time.Weekday, first time.Weekday) int {
+	return (WeekInDays + int(weekday) - int(first)) % WeekInDays
+}
@@ -0,0 +1,74 @@
+package gostradamus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeekCalendar_Week(t *testing.T) {
+	year, week := IsoWeekCalendar.Week(NewUTCDateTime(2012, 12, 31, 12, 0, 0, 0))
+	assert.Equal(t, 2013, year)
+	assert.Equal(t, 1, week)
+
+	year, week = IsoWeekCalendar.Week(NewUTCDateTime(2021, 1, 3, 12, 0, 0, 0))
+	assert.Equal(t, 2020, year)
+	assert.Equal(t, 53, week)
+
+	// January 1st 2022 is a saturday
+	year, week = SundayWeekCalendar.Week(NewUTCDateTime(2022, 1, 1, 12, 0, 0, 0))
+	assert.Equal(t, 2022, year)
+	assert.Equal(t, 1, week)
+
+	year, week = SundayWeekCalendar.Week(NewUTCDateTime(2022, 1, 2, 12, 0, 0, 0))
+	assert.Equal(t, 2022, year)
+	assert.Equal(t, 2, week)
+
+	year, week = SundayWeekCalendar.Week(NewUTCDateTime(2021, 12, 31, 12, 0, 0, 0))
+	assert.Equal(t, 2022, year)
+	assert.Equal(t, 1, week)
+
+	year, week = SaturdayWeekCalendar.Week(NewUTCDateTime(2022, 1, 7, 12, 0, 0, 0))
+	assert.Equal(t, 2022, year)
+	assert.Equal(t, 1, week)
+
+	year, week = SaturdayWeekCalendar.Week(NewUTCDateTime(2022, 1, 8, 12, 0, 0, 0))
+	assert.Equal(t, 2022, year)
+	assert.Equal(t, 2, week)
+}
+
+func TestWeekCalendar_Week_IsoEquivalence(t *testing.T) {
+	dateTime := NewUTCDateTime(2010, 1, 1, 0, 0, 0, 0)
+	for i := 0; i < 3660; i++ {
+		expectedYear, expectedWeek := dateTime.IsoWeek()
+		actualYear, actualWeek := IsoWeekCalendar.Week(dateTime)
+		assert.Equal(t, expectedYear, actualYear, dateTime.String())
+		assert.Equal(t, expectedWeek, actualWeek, dateTime.String())
+		dateTime = dateTime.ShiftDays(1)
+	}
+}
+
+func TestSetDefaultWeekCalendar(t *testing.T) {
+	assert.Equal(t, IsoWeekCalendar, DefaultWeekCalendar())
+
+	SetDefaultWeekCalendar(SundayWeekCalendar)
+	defer SetDefaultWeekCalendar(IsoWeekCalendar)
+
+	assert.Equal(t, SundayWeekCalendar, DefaultWeekCalendar())
+
+	start, end := NewUTCDateTime(2012, 12, 12, 12, 12, 12, 0).SpanWeek()
+	assert.Equal(t, NewUTCDateTime(2012, 12, 9, 0, 0, 0, 0), start)
+	assert.Equal(t, NewUTCDateTime(2012, 12, 15, 23, 59, 59, 999999999), end)
+
+	year, week := NewUTCDateTime(2022, 1, 2, 12, 0, 0, 0).Week()
+	assert.Equal(t, 2022, year)
+	assert.Equal(t, 2, week)
+}
+
+func TestDaysSinceWeekday(t *testing.T) {
+	assert.Equal(t, 0, daysSinceWeekday(time.Monday, time.Monday))
+	assert.Equal(t, 6, daysSinceWeekday(time.Sunday, time.Monday))
+	assert.Equal(t, 1, daysSinceWeekday(time.Sunday, time.Saturday))
+	assert.Equal(t, 6, daysSinceWeekday(time.Friday, time.Saturday))
+}